@@ -2,22 +2,79 @@ package provider
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/ascii85"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"html"
+	"io"
+	"maps"
+	"math"
+	"math/big"
+	"math/rand"
+	"mime/quotedprintable"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
 
+	"github.com/apparentlymart/go-textseg/v15/textseg"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/idna"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 	"golang.org/x/text/runes"
 	"golang.org/x/text/transform"
 	"golang.org/x/text/unicode/norm"
 )
 
-// latinize removes diacritical marks from a string
+// latinize removes diacritical marks from a string, then transliterates the letters whose
+// marks are not separate combining characters
 func latinize(input string) (string, error) {
-	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC, runes.Map(transliterateLatin))
 	result, _, err := transform.String(t, input)
 	return result, err
 }
 
+// latinTransliterations maps Latin letters with a stroke, bar or similar mark that has no
+// canonical decomposition to their base letter. The Turkish dotless ı is deliberately left
+// out, since it is a letter of its own rather than an i with a mark.
+var latinTransliterations = map[rune]rune{
+	'ø': 'o', 'Ø': 'O',
+	'ł': 'l', 'Ł': 'L',
+	'đ': 'd', 'Đ': 'D',
+	'ð': 'd', 'Ð': 'D',
+	'ħ': 'h', 'Ħ': 'H',
+	'ŧ': 't', 'Ŧ': 'T',
+	'ŀ': 'l', 'Ŀ': 'L',
+	'ƀ': 'b', 'Ƀ': 'B',
+	'ɨ': 'i', 'Ɨ': 'I',
+	'ƶ': 'z', 'Ƶ': 'Z',
+}
+
+// transliterateLatin returns the latinTransliterations entry for r, or r itself
+func transliterateLatin(r rune) rune {
+	if base, ok := latinTransliterations[r]; ok {
+		return base
+	}
+	return r
+}
+
 // splitWords splits a latinized string into words by non-alphanumeric characters
 func splitWords(s string) []string {
 	var words []string
@@ -38,27 +95,214 @@ func splitWords(s string) []string {
 	return words
 }
 
-func hasDiacritic(r rune) bool {
-	if !unicode.IsLetter(r) {
-		return false
+// caseOptions holds the optional settings accepted by the word-based case conversion functions
+type caseOptions struct {
+	// splitDigits also splits words between letters and digits, so "version2" becomes "version", "2"
+	splitDigits bool
+	// acronyms lists words that camel and pascal emit in this exact form instead of capitalizing them
+	acronyms []string
+	// locale selects language-specific casing rules, such as the Turkish dotted and dotless i.
+	// language.Und keeps the default Unicode casing.
+	locale language.Tag
+	// capitalSharpS uppercases "ß" to the capital sharp s "ẞ" instead of "SS"
+	capitalSharpS bool
+}
+
+// caseOptionsArgument reads caseOptions from the optional options object at position,
+// accepting only the option names supported by the calling function
+func caseOptionsArgument(values []types.Dynamic, position int64, names ...string) (caseOptions, *function.FuncError) {
+	options, funcErr := optionsArgument(values, position, names...)
+	if funcErr != nil {
+		return caseOptions{}, funcErr
+	}
+	splitDigits, funcErr := boolOption(options, "split_digits", position, false)
+	if funcErr != nil {
+		return caseOptions{}, funcErr
+	}
+	acronyms, funcErr := stringListOption(options, "acronyms", position)
+	if funcErr != nil {
+		return caseOptions{}, funcErr
+	}
+	locale, funcErr := stringOption(options, "locale", position, "")
+	if funcErr != nil {
+		return caseOptions{}, funcErr
 	}
 
-	decomposed := norm.NFD.String(string(r))
-	for _, dr := range decomposed {
-		if unicode.Is(unicode.Mn, dr) {
-			return true
+	tag := language.Und
+	if locale != "" {
+		var err error
+		tag, err = language.Parse(locale)
+		if err != nil {
+			return caseOptions{}, function.NewArgumentFuncError(position, fmt.Sprintf("invalid locale %q: %s", locale, err))
 		}
 	}
+	capitalSharpS, funcErr := boolOption(options, "capital_sharp_s", position, false)
+	if funcErr != nil {
+		return caseOptions{}, funcErr
+	}
+	return caseOptions{splitDigits: splitDigits, acronyms: acronyms, locale: tag, capitalSharpS: capitalSharpS}, nil
+}
 
-	return false
+// latinizeForCase latinizes input for the case conversion functions. Under a Turkic locale
+// the capital dotted I is a letter of its own rather than an I with a diacritic, so it is
+// kept and lowercases to a dotted i instead of being reduced to I, which would lowercase
+// to a dotless ı.
+func latinizeForCase(input string, opts caseOptions) (string, error) {
+	base, _ := opts.locale.Base()
+	if base.String() != "tr" && base.String() != "az" {
+		return latinize(input)
+	}
+
+	parts := strings.Split(input, "İ")
+	for i, part := range parts {
+		latinized, err := latinize(part)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = latinized
+	}
+	return strings.Join(parts, "İ"), nil
+}
+
+// lowerCase lowercases s, using the casing rules of opts.locale if one was given
+func lowerCase(s string, opts caseOptions) string {
+	if opts.locale == language.Und {
+		return strings.ToLower(s)
+	}
+	return cases.Lower(opts.locale).String(s)
+}
+
+// upperCase uppercases s, using the casing rules of opts.locale if one was given. Unlike
+// strings.ToUpper, this maps "ß" to "SS", or to "ẞ" with opts.capitalSharpS.
+func upperCase(s string, opts caseOptions) string {
+	if opts.capitalSharpS {
+		s = strings.ReplaceAll(s, "ß", "ẞ")
+	}
+	return cases.Upper(opts.locale).String(s)
+}
+
+// capitalizeWord capitalizes a word for camel and pascal, or returns its canonical form
+// if it matches one of the configured acronyms, ignoring case
+func capitalizeWord(word string, opts caseOptions) string {
+	for _, acronym := range opts.acronyms {
+		if strings.EqualFold(acronym, word) {
+			return acronym
+		}
+	}
+	return titleWord(word, opts)
+}
+
+// titleWord maps the first rune of a word to title case and lowercases the rest, so that
+// digraphs such as "ǆ" become "ǅ" rather than "Ǆ". The casing rules of opts.locale are
+// used if one was given.
+func titleWord(word string, opts caseOptions) string {
+	first, size := utf8.DecodeRuneInString(word)
+	if size == 0 {
+		return word
+	}
+	if opts.locale == language.Und {
+		return string(unicode.ToTitle(first)) + strings.ToLower(word[size:])
+	}
+	return cases.Title(opts.locale).String(word[:size]) + cases.Lower(opts.locale).String(word[size:])
+}
+
+// splitCaseWords splits a latinized string into words of Latin letters and ASCII digits,
+// and additionally splits words at camelCase and PascalCase boundaries: before an uppercase
+// letter that follows a lowercase letter, and before the last letter of an uppercase run
+// that is followed by a lowercase letter, so "getHTTPResponse" becomes "get", "HTTP",
+// "Response". With opts.splitDigits, words are also split wherever letters and digits meet.
+func splitCaseWords(s string, opts caseOptions) []string {
+	fields := strings.FieldsFunc(s, isNotCaseWordRune)
+
+	var words []string
+	for _, field := range fields {
+		rs := []rune(field)
+		start := 0
+		for i := 1; i < len(rs); i++ {
+			prev, cur := rs[i-1], rs[i]
+			lowerToUpper := unicode.IsLower(prev) && isUpperOrTitle(cur)
+			acronymEnd := isUpperOrTitle(prev) && isUpperOrTitle(cur) && i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			letterDigit := opts.splitDigits && unicode.IsDigit(prev) != unicode.IsDigit(cur)
+			if lowerToUpper || acronymEnd || letterDigit {
+				words = append(words, string(rs[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(rs[start:]))
+	}
+	return words
+}
+
+// caseWords latinizes input and splits it into words for the case conversion functions
+func caseWords(input string, opts caseOptions) ([]string, error) {
+	latinized, err := latinizeForCase(input, opts)
+	if err != nil {
+		return nil, err
+	}
+	return splitCaseWords(latinized, opts), nil
+}
+
+// convertCase splits input into words with caseWords and joins them with join. The join
+// functions leave the words unchanged, so all_cases can pass one split to each of them.
+func convertCase(input string, opts caseOptions, join func([]string, caseOptions) string) (string, error) {
+	words, err := caseWords(input, opts)
+	if err != nil {
+		return "", err
+	}
+	return join(words, opts), nil
+}
+
+// isNotCaseWordRune reports whether r separates words for the case conversion functions, that
+// is whether it is anything other than a Latin letter, an ASCII digit or a combining mark. Letters
+// of other scripts are dropped, so that the identifiers built from the words stay in Latin script.
+func isNotCaseWordRune(r rune) bool {
+	return !unicode.Is(unicode.Latin, r) && !(r >= '0' && r <= '9') && !unicode.IsMark(r)
+}
+
+// isNotWordRune reports whether r separates words, that is whether it is anything other
+// than a letter, digit or combining mark in any script
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+}
+
+// isUpperOrTitle reports whether r is an uppercase or title-case letter
+func isUpperOrTitle(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+// asciiExpansions spells out letters that have a conventional multi-letter ASCII equivalent
+var asciiExpansions = strings.NewReplacer(
+	"ß", "ss", "ẞ", "SS",
+	"æ", "ae", "Æ", "AE",
+	"œ", "oe", "Œ", "OE",
+	"þ", "th", "Þ", "TH",
+)
+
+// stripNonASCII removes all characters outside the ASCII range (0-127)
+func stripNonASCII(s string) string {
+	var result strings.Builder
+	for _, r := range s {
+		if r <= unicode.MaxASCII {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
 }
 
-func isVowel(r rune) bool {
+// isVowel reports whether r is a vowel, judging accented letters by their latinized base
+// letter so that é counts as a vowel while ñ and ç stay consonants
+func isVowel(r rune, treatYAsVowel bool) bool {
+	if base, err := latinize(string(r)); err == nil && base != "" {
+		r, _ = utf8.DecodeRuneInString(base)
+	}
+
 	switch unicode.ToLower(r) {
 	case 'a', 'e', 'i', 'o', 'u':
 		return true
+	case 'y':
+		return treatYAsVowel
 	default:
-		return hasDiacritic(r)
+		return false
 	}
 }
 
@@ -78,25 +322,36 @@ func (f *AsciiFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *AsciiFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Remove non-ASCII characters",
-		Description: "Removes diacritics first, then removes all non-ASCII characters from the input string, keeping only characters with ASCII values 0-127.",
+		Description: "Removes diacritics first, then removes all non-ASCII characters from the input string, keeping only characters with ASCII values 0-127. With expand set to true, letters that are written with several ASCII letters are expanded before stripping instead of being removed: 'ß' becomes 'ss', 'æ' becomes 'ae', 'œ' becomes 'oe' and 'þ' becomes 'th'.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to process",
 			},
 		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "expand",
+			Description: "Optional flag to expand letters such as 'ß' and 'æ' to several ASCII letters, defaults to false",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *AsciiFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
+	var expands []bool
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &expands))
 	if resp.Error != nil {
 		return
 	}
 
+	expand, funcErr := optionalArgument(expands, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
 	// First, latinize the input to remove diacritics
 	latinized, err := latinize(input)
 	if err != nil {
@@ -104,15 +359,13 @@ func (f *AsciiFunction) Run(ctx context.Context, req function.RunRequest, resp *
 		return
 	}
 
-	// Then remove non-ASCII characters
-	var result strings.Builder
-	for _, r := range latinized {
-		if r <= unicode.MaxASCII {
-			result.WriteRune(r)
-		}
+	// Expand letters that have a multi-letter ASCII spelling, if requested
+	if expand {
+		latinized = asciiExpansions.Replace(latinized)
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+	// Then remove non-ASCII characters
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, stripNonASCII(latinized)))
 }
 
 // AsciiPrintableFunction removes all non-printable ASCII characters from a string
@@ -184,7 +437,7 @@ func (f *LatinizeFunction) Metadata(ctx context.Context, req function.MetadataRe
 func (f *LatinizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Remove diacritics (latinize)",
-		Description: "Removes diacritical marks (accents) from characters, converting them to their base Latin equivalents. For example: 'räksmörgås' becomes 'raksmorgas'.",
+		Description: "Removes diacritical marks (accents) from characters, converting them to their base Latin equivalents. Letters whose mark is part of the letter, such as 'ø', 'ł' and 'đ', are transliterated to their base letter too. For example: 'räksmörgås' becomes 'raksmorgas' and 'Łódź' becomes 'Lodz'.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
@@ -212,6 +465,16 @@ func (f *LatinizeFunction) Run(ctx context.Context, req function.RunRequest, res
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toFlat converts a string to flatcase
+func toFlat(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinFlat)
+}
+
+// joinFlat joins words in flatcase
+func joinFlat(words []string, opts caseOptions) string {
+	return lowerCase(strings.Join(words, ""), opts)
+}
+
 // FlatFunction converts to flatcase (all lowercase, no separators)
 var _ function.Function = &FlatFunction{}
 
@@ -228,35 +491,58 @@ func (f *FlatFunction) Metadata(ctx context.Context, req function.MetadataReques
 func (f *FlatFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to flatcase",
-		Description: "Converts to flatcase: all lowercase with no separators. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to flatcase: all lowercase with no separators. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *FlatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toFlat(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	result := strings.ToLower(strings.Join(words, ""))
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toKebab converts a string to kebab-case
+func toKebab(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinKebab)
+}
+
+// joinKebab joins words in kebab-case
+func joinKebab(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = lowerCase(word, opts)
+	}
+	return strings.Join(cased, "-")
+}
+
 // KebabFunction converts to kebab-case (lowercase with hyphens)
 var _ function.Function = &KebabFunction{}
 
@@ -273,38 +559,63 @@ func (f *KebabFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *KebabFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to kebab-case",
-		Description: "Converts to kebab-case: lowercase words separated by hyphens. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to kebab-case: lowercase words separated by hyphens. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *KebabFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toKebab(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	for i := range words {
-		words[i] = strings.ToLower(words[i])
-	}
-	result := strings.Join(words, "-")
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toCamel converts a string to camelCase
+func toCamel(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinCamel)
+}
+
+// joinCamel joins words in camelCase
+func joinCamel(words []string, opts caseOptions) string {
+	if len(words) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	result.WriteString(lowerCase(words[0], opts))
+	for i := 1; i < len(words); i++ {
+		result.WriteString(capitalizeWord(words[i], opts))
+	}
+	return result.String()
+}
+
 // CamelFunction converts to camelCase
 var _ function.Function = &CamelFunction{}
 
@@ -321,42 +632,56 @@ func (f *CamelFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *CamelFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to camelCase",
-		Description: "Converts to camelCase: first word lowercase, subsequent words capitalized, no separators. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to camelCase: first word lowercase, subsequent words capitalized, no separators. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, acronyms (list of strings) gives words to emit exactly as listed instead of capitalizing them, e.g. [\"ID\", \"JSON\"], and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *CamelFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
-	if err != nil {
-		resp.Error = function.NewFuncError(err.Error())
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "acronyms", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
 		return
 	}
 
-	words := splitWords(latinized)
-	if len(words) == 0 {
-		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ""))
+	result, err := toCamel(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toPascal converts a string to PascalCase
+func toPascal(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinPascal)
+}
+
+// joinPascal joins words in PascalCase
+func joinPascal(words []string, opts caseOptions) string {
 	var result strings.Builder
-	result.WriteString(strings.ToLower(words[0]))
-	for i := 1; i < len(words); i++ {
-		result.WriteString(strings.Title(strings.ToLower(words[i])))
+	for _, word := range words {
+		result.WriteString(capitalizeWord(word, opts))
 	}
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+	return result.String()
 }
 
 // PascalFunction converts to PascalCase
@@ -375,36 +700,56 @@ func (f *PascalFunction) Metadata(ctx context.Context, req function.MetadataRequ
 func (f *PascalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to PascalCase",
-		Description: "Converts to PascalCase: all words capitalized, no separators. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to PascalCase: all words capitalized, no separators. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, acronyms (list of strings) gives words to emit exactly as listed instead of capitalizing them, e.g. [\"ID\", \"JSON\"], and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *PascalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "acronyms", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toPascal(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	var result strings.Builder
-	for _, word := range words {
-		result.WriteString(strings.Title(strings.ToLower(word)))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toSnake converts a string to snake_case
+func toSnake(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinSnake)
+}
+
+// joinSnake joins words in snake_case
+func joinSnake(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = lowerCase(word, opts)
 	}
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+	return strings.Join(cased, "_")
 }
 
 // SnakeFunction converts to snake_case
@@ -423,38 +768,58 @@ func (f *SnakeFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *SnakeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to snake_case",
-		Description: "Converts to snake_case: lowercase words separated by underscores. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to snake_case: lowercase words separated by underscores. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *SnakeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toSnake(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	for i := range words {
-		words[i] = strings.ToLower(words[i])
-	}
-	result := strings.Join(words, "_")
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toUpper converts a string to UPPER_CASE
+func toUpper(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinUpper)
+}
+
+// joinUpper joins words in UPPER_CASE
+func joinUpper(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = upperCase(word, opts)
+	}
+	return strings.Join(cased, "_")
+}
+
 // UpperFunction converts to UPPER_CASE
 var _ function.Function = &UpperFunction{}
 
@@ -471,38 +836,58 @@ func (f *UpperFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *UpperFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to UPPER_CASE",
-		Description: "Converts to UPPER_CASE: uppercase words separated by underscores. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to UPPER_CASE: uppercase words separated by underscores. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, locale (string, e.g. \"tr\") applies the casing rules of that language, and capital_sharp_s (bool, defaults to false) uppercases 'ß' to 'ẞ' instead of 'SS'",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *UpperFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale", "capital_sharp_s")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toUpper(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	for i := range words {
-		words[i] = strings.ToUpper(words[i])
-	}
-	result := strings.Join(words, "_")
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toTrain converts a string to TRAIN-CASE
+func toTrain(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinTrain)
+}
+
+// joinTrain joins words in TRAIN-CASE
+func joinTrain(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = upperCase(word, opts)
+	}
+	return strings.Join(cased, "-")
+}
+
 // TrainFunction converts to TRAIN-CASE
 var _ function.Function = &TrainFunction{}
 
@@ -519,38 +904,58 @@ func (f *TrainFunction) Metadata(ctx context.Context, req function.MetadataReque
 func (f *TrainFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to TRAIN-CASE",
-		Description: "Converts to TRAIN-CASE: uppercase words separated by hyphens. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to TRAIN-CASE: uppercase words separated by hyphens. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, locale (string, e.g. \"tr\") applies the casing rules of that language, and capital_sharp_s (bool, defaults to false) uppercases 'ß' to 'ẞ' instead of 'SS'",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *TrainFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale", "capital_sharp_s")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toTrain(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	for i := range words {
-		words[i] = strings.ToUpper(words[i])
-	}
-	result := strings.Join(words, "-")
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
+// toAda converts a string to Ada_Case
+func toAda(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinAda)
+}
+
+// joinAda joins words in Ada_Case
+func joinAda(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = titleWord(word, opts)
+	}
+	return strings.Join(cased, "_")
+}
+
 // AdaFunction converts to Ada_Case
 var _ function.Function = &AdaFunction{}
 
@@ -567,137 +972,6655 @@ func (f *AdaFunction) Metadata(ctx context.Context, req function.MetadataRequest
 func (f *AdaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Convert to Ada_Case",
-		Description: "Converts to Ada_Case: capitalized words separated by underscores. Latinizes first, then splits on non-alphanumeric characters.",
+		Description: "Converts to Ada_Case: capitalized words separated by underscores. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
 func (f *AdaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	latinized, err := latinize(input)
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toAda(input, opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
 	}
 
-	words := splitWords(latinized)
-	for i := range words {
-		words[i] = strings.Title(strings.ToLower(words[i]))
-	}
-	result := strings.Join(words, "_")
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
-// EliteFunction converts to elite case (uppercase consonants, lowercase vowels)
-var _ function.Function = &EliteFunction{}
+// toTitle converts a string to Title Case
+func toTitle(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinTitle)
+}
 
-type EliteFunction struct{}
+// joinTitle joins words in Title Case
+func joinTitle(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = titleWord(word, opts)
+	}
+	return strings.Join(cased, " ")
+}
 
-func NewEliteFunction() function.Function {
-	return &EliteFunction{}
+// TitleFunction converts to Title Case
+var _ function.Function = &TitleFunction{}
+
+type TitleFunction struct{}
+
+func NewTitleFunction() function.Function {
+	return &TitleFunction{}
 }
 
-func (f *EliteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "elite"
+func (f *TitleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "title"
 }
 
-func (f *EliteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+func (f *TitleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary:     "Convert to elite case",
-		Description: "Uppercases consonants and lowercases vowels, leaving non-letter characters unchanged. Treats letters with diacritics as vowels.",
+		Summary:     "Convert to Title Case",
+		Description: "Converts to Title Case: capitalized words separated by single spaces. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
-func (f *EliteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+func (f *TitleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	var result strings.Builder
-	for _, r := range input {
-		if unicode.IsLetter(r) {
-			if isVowel(r) {
-				result.WriteRune(unicode.ToLower(r))
-			} else {
-				result.WriteRune(unicode.ToUpper(r))
-			}
-		} else {
-			result.WriteRune(r)
-		}
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+	result, err := toTitle(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
 }
 
-// SpongeFunction converts to sponge case (alternate lowercase/uppercase on letters)
-var _ function.Function = &SpongeFunction{}
+// toSentence converts a string to Sentence case
+func toSentence(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinSentence)
+}
 
-type SpongeFunction struct{}
+// joinSentence joins words in Sentence case
+func joinSentence(words []string, opts caseOptions) string {
+	if len(words) == 0 {
+		return ""
+	}
 
-func NewSpongeFunction() function.Function {
-	return &SpongeFunction{}
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = lowerCase(word, opts)
+	}
+	cased[0] = titleWord(words[0], opts)
+	return strings.Join(cased, " ")
 }
 
-func (f *SpongeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "sponge"
+// SentenceFunction converts to Sentence case
+var _ function.Function = &SentenceFunction{}
+
+type SentenceFunction struct{}
+
+func NewSentenceFunction() function.Function {
+	return &SentenceFunction{}
 }
 
-func (f *SpongeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+func (f *SentenceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sentence"
+}
+
+func (f *SentenceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary:     "Convert to sponge case",
-		Description: "Alternates lowercase and uppercase letters, starting with lowercase for each word. Non-letter characters are unchanged and reset the alternation.",
+		Summary:     "Convert to Sentence case",
+		Description: "Converts to Sentence case: lowercase words separated by single spaces, with only the first word capitalized. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to convert",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
 		Return: function.StringReturn{},
 	}
 }
 
-func (f *SpongeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+func (f *SentenceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
 	if resp.Error != nil {
 		return
 	}
 
-	var result strings.Builder
-	useLower := true
-	for _, r := range input {
-		if unicode.IsLetter(r) {
-			if useLower {
-				result.WriteRune(unicode.ToLower(r))
-			} else {
-				result.WriteRune(unicode.ToUpper(r))
-			}
-			useLower = !useLower
-		} else {
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toSentence(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toDot converts a string to dot.case
+func toDot(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinDot)
+}
+
+// joinDot joins words in dot.case
+func joinDot(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = lowerCase(word, opts)
+	}
+	return strings.Join(cased, ".")
+}
+
+// DotFunction converts to dot.case
+var _ function.Function = &DotFunction{}
+
+type DotFunction struct{}
+
+func NewDotFunction() function.Function {
+	return &DotFunction{}
+}
+
+func (f *DotFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dot"
+}
+
+func (f *DotFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to dot.case",
+		Description: "Converts to dot.case: lowercase words separated by dots. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DotFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toDot(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toPath converts a string to path/case
+func toPath(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinPath)
+}
+
+// joinPath joins words in path/case
+func joinPath(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = lowerCase(word, opts)
+	}
+	return strings.Join(cased, "/")
+}
+
+// PathFunction converts to path/case
+var _ function.Function = &PathFunction{}
+
+type PathFunction struct{}
+
+func NewPathFunction() function.Function {
+	return &PathFunction{}
+}
+
+func (f *PathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "path"
+}
+
+func (f *PathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to path/case",
+		Description: "Converts to path/case: lowercase words separated by slashes. Latinizes first, then splits on characters other than Latin letters and digits, including any existing slashes, and at camelCase boundaries.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toPath(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toHttpHeader converts a string to HTTP-Header-Case
+func toHttpHeader(input string, opts caseOptions) (string, error) {
+	return convertCase(input, opts, joinHttpHeader)
+}
+
+// joinHttpHeader joins words in HTTP-Header-Case
+func joinHttpHeader(words []string, opts caseOptions) string {
+	cased := make([]string, len(words))
+	for i, word := range words {
+		cased[i] = titleWord(word, opts)
+	}
+	return strings.Join(cased, "-")
+}
+
+// HttpHeaderFunction converts to HTTP-Header-Case
+var _ function.Function = &HttpHeaderFunction{}
+
+type HttpHeaderFunction struct{}
+
+func NewHttpHeaderFunction() function.Function {
+	return &HttpHeaderFunction{}
+}
+
+func (f *HttpHeaderFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "http_header"
+}
+
+func (f *HttpHeaderFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to HTTP-Header-Case",
+		Description: "Converts to HTTP-Header-Case: capitalized words separated by hyphens, as in canonical HTTP header names. Latinizes first, then splits on characters other than Latin letters and digits and at camelCase boundaries.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits, and locale (string, e.g. \"tr\") applies the casing rules of that language",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HttpHeaderFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits", "locale")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toHttpHeader(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toElite converts a string to elite case
+func toElite(input string, treatYAsVowel bool) string {
+	var result strings.Builder
+	for _, r := range input {
+		if unicode.IsLetter(r) {
+			if isVowel(r, treatYAsVowel) {
+				result.WriteRune(unicode.ToLower(r))
+			} else {
+				result.WriteRune(unicode.ToUpper(r))
+			}
+		} else {
 			result.WriteRune(r)
+		}
+	}
+
+	return result.String()
+}
+
+// EliteFunction converts to elite case (uppercase consonants, lowercase vowels)
+var _ function.Function = &EliteFunction{}
+
+type EliteFunction struct{}
+
+func NewEliteFunction() function.Function {
+	return &EliteFunction{}
+}
+
+func (f *EliteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "elite"
+}
+
+func (f *EliteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to elite case",
+		Description: "Uppercases consonants and lowercases vowels, leaving non-letter characters unchanged. Accented letters follow their base letter, so é is a vowel and ñ is a consonant. 'y' is a consonant unless treat_y_as_vowel is true.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "treat_y_as_vowel",
+			Description: "Optional flag to treat 'y' as a vowel, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EliteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var treatYAsVowels []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &treatYAsVowels))
+	if resp.Error != nil {
+		return
+	}
+
+	treatYAsVowel, funcErr := optionalArgument(treatYAsVowels, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := toElite(input, treatYAsVowel)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toSponge converts a string to sponge case. Each grapheme cluster is one step of the
+// alternation, so a letter keeps its combining marks; any cluster that does not start with
+// a letter is kept as is and restarts the alternation.
+func toSponge(input string) (string, error) {
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		return "", err
+	}
+
+	var result strings.Builder
+	useLower := true
+	for _, cluster := range clusters {
+		first, size := utf8.DecodeRuneInString(cluster)
+		if !unicode.IsLetter(first) {
+			result.WriteString(cluster)
 			useLower = true
+			continue
+		}
+
+		if useLower {
+			result.WriteRune(unicode.ToLower(first))
+		} else {
+			result.WriteRune(unicode.ToUpper(first))
 		}
+		result.WriteString(cluster[size:])
+		useLower = !useLower
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+	return result.String(), nil
+}
+
+// SpongeFunction converts to sponge case (alternate lowercase/uppercase on letters)
+var _ function.Function = &SpongeFunction{}
+
+type SpongeFunction struct{}
+
+func NewSpongeFunction() function.Function {
+	return &SpongeFunction{}
+}
+
+func (f *SpongeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sponge"
+}
+
+func (f *SpongeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to sponge case",
+		Description: "Alternates lowercase and uppercase letters, starting with lowercase for each word. Each grapheme cluster is one step, so a letter with combining marks counts once. Non-letter characters are unchanged and reset the alternation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SpongeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := toSponge(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// toRandomCase upper- or lowercases each letter at random, using a source seeded from a hash
+// of seed and input so that the same arguments always give the same result
+func toRandomCase(input string, seed string) (string, error) {
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		return "", err
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(seed))
+	h.Write([]byte{0})
+	h.Write([]byte(input))
+	rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	var result strings.Builder
+	for _, cluster := range clusters {
+		first, size := utf8.DecodeRuneInString(cluster)
+		if !unicode.IsLetter(first) {
+			result.WriteString(cluster)
+			continue
+		}
+
+		if rng.Intn(2) == 0 {
+			result.WriteRune(unicode.ToLower(first))
+		} else {
+			result.WriteRune(unicode.ToUpper(first))
+		}
+		result.WriteString(cluster[size:])
+	}
+
+	return result.String(), nil
+}
+
+// RandomCaseFunction converts to random case, deterministically derived from the input
+var _ function.Function = &RandomCaseFunction{}
+
+type RandomCaseFunction struct{}
+
+func NewRandomCaseFunction() function.Function {
+	return &RandomCaseFunction{}
+}
+
+func (f *RandomCaseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "random_case"
+}
+
+func (f *RandomCaseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to random case",
+		Description: "Uppercases or lowercases each letter pseudo-randomly, also known as studly caps. The choices are derived from a hash of the input and the optional seed, so the same arguments always give the same result. Non-letter characters are unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "seed",
+			Description: "Optional seed to vary the result, defaults to an empty string",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RandomCaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var seeds []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &seeds))
+	if resp.Error != nil {
+		return
+	}
+
+	seed, funcErr := optionalArgument(seeds, 1, "")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := toRandomCase(input, seed)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// FoldAsciiFunction folds a string into a lowercase ASCII search token string
+var _ function.Function = &FoldAsciiFunction{}
+
+type FoldAsciiFunction struct{}
+
+func NewFoldAsciiFunction() function.Function {
+	return &FoldAsciiFunction{}
+}
+
+func (f *FoldAsciiFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fold_ascii"
+}
+
+func (f *FoldAsciiFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Fold to a lowercase ASCII search string",
+		Description: "Latinizes, removes remaining non-ASCII characters, lowercases, and collapses runs of whitespace into single spaces with no leading or trailing whitespace. For example: 'Crème Brûlée 世界' becomes 'creme brulee'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to fold",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FoldAsciiFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	words := strings.Fields(stripNonASCII(latinized))
+	result := strings.ToLower(strings.Join(words, " "))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// chunkRunes splits runes into groups of size, with the last group holding any remainder
+func chunkRunes(rs []rune, size int) []string {
+	var chunks []string
+	for start := 0; start < len(rs); start += size {
+		end := min(start+size, len(rs))
+		chunks = append(chunks, string(rs[start:end]))
+	}
+	return chunks
+}
+
+// ChunkFunction breaks a string into fixed-size groups joined by a separator
+var _ function.Function = &ChunkFunction{}
+
+type ChunkFunction struct{}
+
+func NewChunkFunction() function.Function {
+	return &ChunkFunction{}
+}
+
+func (f *ChunkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "chunk"
+}
+
+func (f *ChunkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split into fixed-size groups",
+		Description: "Breaks the input into groups of size characters, starting from the left, and joins them with the separator. The last group holds any remaining characters. For example: chunk('4111111111111111', 4, ' ') becomes '4111 1111 1111 1111'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to split",
+			},
+			function.Int64Parameter{
+				Name:        "size",
+				Description: "The number of characters in each group, must be greater than zero",
+			},
+			function.StringParameter{
+				Name:        "separator",
+				Description: "The string placed between groups",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ChunkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, separator string
+	var size int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &size, &separator))
+	if resp.Error != nil {
+		return
+	}
+
+	if size <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "size must be greater than zero")
+		return
+	}
+
+	result := strings.Join(chunkRunes([]rune(input), int(size)), separator)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// GroupFromRightFunction groups a string into fixed-size groups counted from the right
+var _ function.Function = &GroupFromRightFunction{}
+
+type GroupFromRightFunction struct{}
+
+func NewGroupFromRightFunction() function.Function {
+	return &GroupFromRightFunction{}
+}
+
+func (f *GroupFromRightFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "group_from_right"
+}
+
+func (f *GroupFromRightFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split into fixed-size groups from the right",
+		Description: "Breaks the input into groups of size characters, counting from the right, and joins them with the separator. The first group holds any remaining characters. For example: group_from_right('1234567', 3, ',') becomes '1,234,567'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to split",
+			},
+			function.Int64Parameter{
+				Name:        "size",
+				Description: "The number of characters in each group, must be greater than zero",
+			},
+			function.StringParameter{
+				Name:        "separator",
+				Description: "The string placed between groups",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GroupFromRightFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, separator string
+	var size int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &size, &separator))
+	if resp.Error != nil {
+		return
+	}
+
+	if size <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "size must be greater than zero")
+		return
+	}
+
+	result := strings.Join(groupFromRight([]rune(input), int(size)), separator)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// groupFromRight splits runes into groups of size counted from the right, with the first group holding any remainder
+func groupFromRight(rs []rune, size int) []string {
+	head := len(rs) % size
+	if head == 0 {
+		return chunkRunes(rs, size)
+	}
+	return append([]string{string(rs[:head])}, chunkRunes(rs[head:], size)...)
+}
+
+// FormatNumberFunction formats a number with grouping and decimal separators
+var _ function.Function = &FormatNumberFunction{}
+
+type FormatNumberFunction struct{}
+
+func NewFormatNumberFunction() function.Function {
+	return &FormatNumberFunction{}
+}
+
+func (f *FormatNumberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_number"
+}
+
+func (f *FormatNumberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Format a number with thousands separators",
+		Description: "Formats a number in plain decimal notation, inserting the separator between groups of three integer digits and using decimal_sep before any fractional digits. For example: format_number(1234567.89, ',', '.') becomes '1,234,567.89'.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:        "value",
+				Description: "The number to format",
+			},
+			function.StringParameter{
+				Name:        "separator",
+				Description: "The string placed between groups of three integer digits",
+			},
+			function.StringParameter{
+				Name:        "decimal_sep",
+				Description: "The string placed between the integer and fractional digits",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatNumberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value *big.Float
+	var separator, decimalSep string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &separator, &decimalSep))
+	if resp.Error != nil {
+		return
+	}
+
+	if value.IsInf() {
+		resp.Error = function.NewArgumentFuncError(0, "value must be a finite number")
+		return
+	}
+
+	var result strings.Builder
+	if value.Sign() < 0 {
+		result.WriteString("-")
+	}
+
+	// Shortest decimal representation of the absolute value, e.g. "1234567.89"
+	text := new(big.Float).Abs(value).Text('f', -1)
+	integer, fraction, hasFraction := strings.Cut(text, ".")
+
+	result.WriteString(strings.Join(groupFromRight([]rune(integer), 3), separator))
+	if hasFraction {
+		result.WriteString(decimalSep)
+		result.WriteString(fraction)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// optionalArgument returns the value given for an optional trailing argument, which is
+// implemented as a variadic parameter at position, or def when it was omitted
+func optionalArgument[T any](values []T, position int64, def T) (T, *function.FuncError) {
+	switch len(values) {
+	case 0:
+		return def, nil
+	case 1:
+		return values[0], nil
+	default:
+		return def, function.NewArgumentFuncError(position+1, "too many arguments: at most one optional argument may be given")
+	}
+}
+
+// ExpandTabsFunction replaces tabs with spaces up to the next tab stop
+var _ function.Function = &ExpandTabsFunction{}
+
+type ExpandTabsFunction struct{}
+
+func NewExpandTabsFunction() function.Function {
+	return &ExpandTabsFunction{}
+}
+
+func (f *ExpandTabsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_tabs"
+}
+
+func (f *ExpandTabsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Expand tabs to spaces",
+		Description: "Replaces each tab character with the number of spaces needed to reach the next tab stop, tracking the column across each line. For example, with the default tab width of 8: 'ab\\tc' becomes 'ab      c'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to expand",
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:        "tabwidth",
+			Description: "Optional number of columns between tab stops, defaults to 8",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ExpandTabsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var tabwidths []int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &tabwidths))
+	if resp.Error != nil {
+		return
+	}
+
+	tabwidth, funcErr := optionalArgument(tabwidths, 1, 8)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if tabwidth <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "tabwidth must be greater than zero")
+		return
+	}
+
+	var result strings.Builder
+	var column int64
+	for _, r := range input {
+		switch r {
+		case '\t':
+			spaces := tabwidth - column%tabwidth
+			result.WriteString(strings.Repeat(" ", int(spaces)))
+			column += spaces
+		case '\n', '\r':
+			result.WriteRune(r)
+			column = 0
+		default:
+			result.WriteRune(r)
+			column++
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// AbbreviateNameFunction shortens middle names to initials
+var _ function.Function = &AbbreviateNameFunction{}
+
+type AbbreviateNameFunction struct{}
+
+func NewAbbreviateNameFunction() function.Function {
+	return &AbbreviateNameFunction{}
+}
+
+func (f *AbbreviateNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "abbreviate_name"
+}
+
+func (f *AbbreviateNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Abbreviate middle names to initials",
+		Description: "Keeps the first and last words in full and reduces each middle word to an uppercase initial followed by a dot, joining the result with spaces. Words are separated by whitespace, so hyphenated and apostrophe names such as 'Jean-Luc' and 'O'Brien' stay whole, and are kept as written, accents and all. Each initial is the first user-perceived character of its word. For example: 'John Ronald Reuel Tolkien' becomes 'John R. R. Tolkien' and 'José María Aznar' becomes 'José M. Aznar'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The name to abbreviate",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AbbreviateNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	words := strings.Fields(input)
+	for i := 1; i < len(words)-1; i++ {
+		clusters, err := graphemeClusters(words[i])
+		if err != nil {
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+		words[i] = strings.ToUpper(clusters[0]) + "."
+	}
+	result := strings.Join(words, " ")
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// OxfordJoinFunction joins a list with commas and a conjunction before the last item
+var _ function.Function = &OxfordJoinFunction{}
+
+type OxfordJoinFunction struct{}
+
+func NewOxfordJoinFunction() function.Function {
+	return &OxfordJoinFunction{}
+}
+
+func (f *OxfordJoinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "oxford_join"
+}
+
+func (f *OxfordJoinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Join a list as an English enumeration",
+		Description: "Joins the list items with commas and an Oxford 'and' before the last item. For example: ['a', 'b', 'c'] becomes 'a, b, and c', ['a', 'b'] becomes 'a and b', a single item is returned as is and an empty list becomes ''.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "list",
+				Description: "The items to join",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "use_or",
+			Description: "Optional flag to join with 'or' instead of 'and', defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *OxfordJoinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var list []string
+	var useOrs []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &list, &useOrs))
+	if resp.Error != nil {
+		return
+	}
+
+	useOr, funcErr := optionalArgument(useOrs, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	conjunction := "and"
+	if useOr {
+		conjunction = "or"
+	}
+
+	var result string
+	switch len(list) {
+	case 0:
+		result = ""
+	case 1:
+		result = list[0]
+	case 2:
+		result = list[0] + " " + conjunction + " " + list[1]
+	default:
+		last := len(list) - 1
+		result = strings.Join(list[:last], ", ") + ", " + conjunction + " " + list[last]
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// zalgoMaxMarks is the number of combining marks kept per base character by strip_zalgo,
+// enough for legitimate stacked diacritics such as Vietnamese 'ệ'
+const zalgoMaxMarks = 2
+
+// StripZalgoFunction removes excessive combining marks from a string
+var _ function.Function = &StripZalgoFunction{}
+
+type StripZalgoFunction struct{}
+
+func NewStripZalgoFunction() function.Function {
+	return &StripZalgoFunction{}
+}
+
+func (f *StripZalgoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_zalgo"
+}
+
+func (f *StripZalgoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove excessive combining marks (zalgo text)",
+		Description: "Decomposes the input and keeps at most two combining marks per base character, dropping the rest, so ordinary accented text survives while stacked 'zalgo' marks are removed. Optionally removes all combining marks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to clean",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "remove_all",
+			Description: "Optional flag to remove every combining mark, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripZalgoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var removeAlls []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &removeAlls))
+	if resp.Error != nil {
+		return
+	}
+
+	removeAll, funcErr := optionalArgument(removeAlls, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	maxMarks := zalgoMaxMarks
+	if removeAll {
+		maxMarks = 0
+	}
+
+	var result strings.Builder
+	marks := 0
+	for _, r := range norm.NFD.String(input) {
+		if unicode.In(r, unicode.Mn, unicode.Me) {
+			marks++
+			if marks > maxMarks {
+				continue
+			}
+		} else {
+			marks = 0
+		}
+		result.WriteRune(r)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, norm.NFC.String(result.String())))
+}
+
+// matchCase returns replacement cased like match: all caps, capitalized or lowercase.
+// Any other casing of match leaves replacement unchanged.
+func matchCase(match, replacement string) string {
+	switch {
+	case strings.ToUpper(match) == match && strings.ToLower(match) != match:
+		return strings.ToUpper(replacement)
+	case strings.ToLower(match) == match:
+		return strings.ToLower(replacement)
+	}
+
+	first, size := utf8.DecodeRuneInString(match)
+	if isUpperOrTitle(first) && strings.ToLower(match[size:]) == match[size:] {
+		return titleWord(replacement, caseOptions{})
+	}
+
+	return replacement
+}
+
+// SmartReplaceFunction replaces text case-insensitively, preserving the casing of each match
+var _ function.Function = &SmartReplaceFunction{}
+
+type SmartReplaceFunction struct{}
+
+func NewSmartReplaceFunction() function.Function {
+	return &SmartReplaceFunction{}
+}
+
+func (f *SmartReplaceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "smart_replace"
+}
+
+func (f *SmartReplaceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Replace text, preserving case",
+		Description: "Replaces every case-insensitive occurrence of old with new, adopting the casing of each match: all caps, capitalized or lowercase. Matches with any other casing get new as given. For example: replacing 'dog' with 'cat' turns 'Dog' into 'Cat' and 'DOG' into 'CAT'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to search",
+			},
+			function.StringParameter{
+				Name:        "old",
+				Description: "The text to find, matched case-insensitively",
+			},
+			function.StringParameter{
+				Name:        "new",
+				Description: "The replacement text",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SmartReplaceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, old, replacement string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &old, &replacement))
+	if resp.Error != nil {
+		return
+	}
+
+	if old == "" {
+		resp.Error = function.NewArgumentFuncError(1, "old must not be empty")
+		return
+	}
+
+	re := regexp.MustCompile("(?i)" + regexp.QuoteMeta(old))
+	result := re.ReplaceAllStringFunc(input, func(match string) string {
+		return matchCase(match, replacement)
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// caseStyles maps style names to their conversions, for functions that select a style by name
+var caseStyles = map[string]func(string, caseOptions) (string, error){
+	"flat":        toFlat,
+	"kebab":       toKebab,
+	"camel":       toCamel,
+	"pascal":      toPascal,
+	"snake":       toSnake,
+	"upper":       toUpper,
+	"train":       toTrain,
+	"ada":         toAda,
+	"title":       toTitle,
+	"sentence":    toSentence,
+	"dot":         toDot,
+	"path":        toPath,
+	"http_header": toHttpHeader,
+	"elite": func(input string, opts caseOptions) (string, error) {
+		return toElite(input, false), nil
+	},
+	"sponge": func(input string, opts caseOptions) (string, error) {
+		return toSponge(input)
+	},
+}
+
+// lookupCaseStyle returns the conversion for a style name, or an argument error at position
+// listing the valid names
+func lookupCaseStyle(style string, position int64) (func(string, caseOptions) (string, error), *function.FuncError) {
+	convert, ok := caseStyles[style]
+	if !ok {
+		names := slices.Sorted(maps.Keys(caseStyles))
+		return nil, function.NewArgumentFuncError(position, fmt.Sprintf("unknown style %q, expected one of: %s", style, strings.Join(names, ", ")))
+	}
+	return convert, nil
+}
+
+// RecaseListFunction converts every string in a list to the same case style
+var _ function.Function = &RecaseListFunction{}
+
+type RecaseListFunction struct{}
+
+func NewRecaseListFunction() function.Function {
+	return &RecaseListFunction{}
+}
+
+func (f *RecaseListFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "recase_list"
+}
+
+func (f *RecaseListFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a list of strings to one case style",
+		Description: "Applies the named case conversion to every element of the list, preserving order. The style is the name of one of the case conversion functions, such as 'snake', 'kebab' or 'camel'. Empty strings are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "list",
+				Description: "The strings to convert",
+			},
+			function.StringParameter{
+				Name:        "style",
+				Description: "The case style to convert to, e.g. 'snake'",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *RecaseListFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var list []string
+	var style string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &list, &style))
+	if resp.Error != nil {
+		return
+	}
+
+	convert, funcErr := lookupCaseStyle(style, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := make([]string, len(list))
+	for i, item := range list {
+		if item == "" {
+			continue
+		}
+
+		converted, err := convert(item, caseOptions{})
+		if err != nil {
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+		result[i] = converted
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// ConvertFunction converts a string to a case style chosen by name
+var _ function.Function = &ConvertFunction{}
+
+type ConvertFunction struct{}
+
+func NewConvertFunction() function.Function {
+	return &ConvertFunction{}
+}
+
+func (f *ConvertFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert"
+}
+
+func (f *ConvertFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a string to a case style chosen by name",
+		Description: "Applies the named case conversion to the input. The style is the name of one of the case conversion functions, such as 'snake', 'kebab' or 'camel', which makes it possible to choose the style from a variable.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "style",
+				Description: "The case style to convert to, e.g. 'snake'",
+			},
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConvertFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var style string
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &style, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	convert, funcErr := lookupCaseStyle(style, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := convert(input, caseOptions{})
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// caseDetectors lists the patterns recognised by DetectCaseFunction, in order of precedence.
+// Inputs that fit several styles, such as a single word, get the first style that matches.
+var caseDetectors = []struct {
+	style   string
+	pattern *regexp.Regexp
+}{
+	{"flat", regexp.MustCompile(`^[a-z0-9]+$`)},
+	{"upper", regexp.MustCompile(`^[A-Z0-9]+(_[A-Z0-9]+)*$`)},
+	{"train", regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]+)+$`)},
+	{"snake", regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)+$`)},
+	{"kebab", regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)+$`)},
+	{"dot", regexp.MustCompile(`^[a-z0-9]+(\.[a-z0-9]+)+$`)},
+	{"path", regexp.MustCompile(`^[a-z0-9]+(/[a-z0-9]+)+$`)},
+	{"camel", regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z0-9][a-z0-9]*)+$`)},
+	{"pascal", regexp.MustCompile(`^([A-Z0-9][a-z0-9]*)+$`)},
+	{"ada", regexp.MustCompile(`^[A-Z0-9][a-z0-9]*(_[A-Z0-9][a-z0-9]*)+$`)},
+	{"http_header", regexp.MustCompile(`^[A-Z0-9][a-z0-9]*(-[A-Z0-9][a-z0-9]*)+$`)},
+	{"title", regexp.MustCompile(`^[A-Z0-9][a-z0-9]*( [A-Z0-9][a-z0-9]*)+$`)},
+	{"sentence", regexp.MustCompile(`^[A-Z0-9][a-z0-9]*( [a-z0-9]+)+$`)},
+}
+
+// DetectCaseFunction identifies the case style a string is written in
+var _ function.Function = &DetectCaseFunction{}
+
+type DetectCaseFunction struct{}
+
+func NewDetectCaseFunction() function.Function {
+	return &DetectCaseFunction{}
+}
+
+func (f *DetectCaseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "detect_case"
+}
+
+func (f *DetectCaseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Detect the case style of a string",
+		Description: "Returns the name of the case style the string is written in, using the same names as the case conversion functions, or 'unknown' if it matches none. When several styles fit, as with a single word, the first match wins in this order: flat, upper, train, snake, kebab, dot, path, camel, pascal, ada, http_header, title, sentence. So 'hello' is flat, 'HELLO' is upper and 'Hello' is pascal.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to inspect",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DetectCaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result := "unknown"
+	for _, detector := range caseDetectors {
+		if detector.pattern.MatchString(input) {
+			result = detector.style
+			break
+		}
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// UrlRemoveParamsFunction removes selected query parameters from a URL
+var _ function.Function = &UrlRemoveParamsFunction{}
+
+type UrlRemoveParamsFunction struct{}
+
+func NewUrlRemoveParamsFunction() function.Function {
+	return &UrlRemoveParamsFunction{}
+}
+
+func (f *UrlRemoveParamsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_remove_params"
+}
+
+func (f *UrlRemoveParamsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove query parameters from a URL",
+		Description: "Parses the URL, which must be absolute with a scheme and host, removes every query parameter whose name is in params, and keeps the remaining parameters exactly as written and in their original order. The fragment is preserved. For example, removing ['utm_source'] from 'https://example.com/?utm_source=x&id=1#top' gives 'https://example.com/?id=1#top'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The URL to clean",
+			},
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "params",
+				Description: "The names of the query parameters to remove, e.g. ['utm_source', 'fbclid']",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UrlRemoveParamsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var params []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &params))
+	if resp.Error != nil {
+		return
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if u.Scheme == "" || u.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid URL %q: expected an absolute URL with a scheme and host", input))
+		return
+	}
+
+	// Filter the raw pairs by their decoded name, so the pairs that are kept stay byte for byte
+	var kept []string
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		key, _, _ := strings.Cut(pair, "=")
+		if name, err := url.QueryUnescape(key); err == nil {
+			key = name
+		}
+		if !slices.Contains(params, key) {
+			kept = append(kept, pair)
+		}
+	}
+	u.RawQuery = strings.Join(kept, "&")
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, u.String()))
+}
+
+// isCased reports whether r is a letter with case
+func isCased(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsLower(r) || unicode.IsTitle(r)
+}
+
+// CapitalizeFunction uppercases the first cased letter of a string
+var _ function.Function = &CapitalizeFunction{}
+
+type CapitalizeFunction struct{}
+
+func NewCapitalizeFunction() function.Function {
+	return &CapitalizeFunction{}
+}
+
+func (f *CapitalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "capitalize"
+}
+
+func (f *CapitalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Uppercase the first letter",
+		Description: "Uppercases the first cased letter of the string, skipping any leading non-letters, and leaves everything else untouched. Letters with a distinct title-case form, such as the digraph 'ǆ', become that form ('ǅ') rather than all caps. A string without cased letters is returned unchanged. For example: 'hELLO wORLD' becomes 'HELLO wORLD'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to capitalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CapitalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result := input
+	if i := strings.IndexFunc(input, isCased); i >= 0 {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		result = input[:i] + string(unicode.ToTitle(r)) + input[i+size:]
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// HandleFunction converts a string to a lowercase social media style handle
+var _ function.Function = &HandleFunction{}
+
+type HandleFunction struct{}
+
+func NewHandleFunction() function.Function {
+	return &HandleFunction{}
+}
+
+func (f *HandleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "handle"
+}
+
+func (f *HandleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to a username handle",
+		Description: "Converts to a handle of lowercase ASCII letters, digits and underscores. Latinizes first, then joins the alphanumeric words with single underscores and truncates to max_length, never leaving a leading or trailing underscore. For example: 'Jöhn Doe-Smith!' becomes 'john_doe_smith'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+			function.Int64Parameter{
+				Name:        "max_length",
+				Description: "The maximum length of the handle, must be greater than zero",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HandleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var maxLength int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &maxLength))
+	if resp.Error != nil {
+		return
+	}
+
+	if maxLength <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "max_length must be greater than zero")
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := strings.Join(splitWords(strings.ToLower(latinized)), "_")
+	if int64(len(result)) > maxLength {
+		result = strings.TrimRight(result[:maxLength], "_")
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// isBidiControl reports whether r is a Unicode bidirectional embedding, override or
+// isolate control (LRE, RLE, PDF, LRO, RLO, LRI, RLI, FSI, PDI)
+func isBidiControl(r rune) bool {
+	return (r >= '\u202A' && r <= '\u202E') || (r >= '\u2066' && r <= '\u2069')
+}
+
+// StripBidiFunction removes bidirectional control characters from a string
+var _ function.Function = &StripBidiFunction{}
+
+type StripBidiFunction struct{}
+
+func NewStripBidiFunction() function.Function {
+	return &StripBidiFunction{}
+}
+
+func (f *StripBidiFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_bidi"
+}
+
+func (f *StripBidiFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove bidirectional control characters",
+		Description: "Removes the Unicode bidirectional embedding, override and isolate controls (LRE, RLE, PDF, LRO, RLO, LRI, RLI, FSI and PDI) that enable 'Trojan Source' attacks, leaving right-to-left letters intact.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to clean",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripBidiFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result := strings.Map(func(r rune) rune {
+		if isBidiControl(r) {
+			return -1
+		}
+		return r
+	}, input)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// HasBidiControlsFunction reports whether a string contains bidirectional control characters
+var _ function.Function = &HasBidiControlsFunction{}
+
+type HasBidiControlsFunction struct{}
+
+func NewHasBidiControlsFunction() function.Function {
+	return &HasBidiControlsFunction{}
+}
+
+func (f *HasBidiControlsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "has_bidi_controls"
+}
+
+func (f *HasBidiControlsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check for bidirectional control characters",
+		Description: "Returns true if the string contains any of the Unicode bidirectional embedding, override or isolate controls removed by strip_bidi.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *HasBidiControlsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result := strings.ContainsFunc(input, isBidiControl)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// titleAndSlug holds the result of TitleAndSlugFunction
+type titleAndSlug struct {
+	Title string `tfsdk:"title"`
+	Slug  string `tfsdk:"slug"`
+}
+
+// TitleAndSlugFunction converts to Title Case and a URL slug from a single word split
+var _ function.Function = &TitleAndSlugFunction{}
+
+type TitleAndSlugFunction struct{}
+
+func NewTitleAndSlugFunction() function.Function {
+	return &TitleAndSlugFunction{}
+}
+
+func (f *TitleAndSlugFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "title_and_slug"
+}
+
+func (f *TitleAndSlugFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to a Title Case title and a URL slug",
+		Description: "Returns an object with a 'title' attribute in Title Case and a 'slug' attribute in lowercase kebab-case. Both are built from the same words, so the slug always matches the title.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"title": types.StringType,
+				"slug":  types.StringType,
+			},
+		},
+	}
+}
+
+func (f *TitleAndSlugFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	words := splitCaseWords(latinized, caseOptions{})
+	titleWords := make([]string, len(words))
+	slugWords := make([]string, len(words))
+	for i, word := range words {
+		titleWords[i] = titleWord(word, caseOptions{})
+		slugWords[i] = strings.ToLower(word)
+	}
+	result := titleAndSlug{
+		Title: strings.Join(titleWords, " "),
+		Slug:  strings.Join(slugWords, "-"),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// leadingArticle matches a title that starts with an English article followed by more words
+var leadingArticle = regexp.MustCompile(`(?is)^(the|an|a)\s+(\S.*)$`)
+
+// StripArticleFunction removes a leading article from a title
+var _ function.Function = &StripArticleFunction{}
+
+type StripArticleFunction struct{}
+
+func NewStripArticleFunction() function.Function {
+	return &StripArticleFunction{}
+}
+
+func (f *StripArticleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_article"
+}
+
+func (f *StripArticleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove a leading article from a title",
+		Description: "Removes a leading 'The', 'A' or 'An' (in any case) and returns the trimmed remainder, so that titles sort by their first significant word: 'The Matrix' becomes 'Matrix'. When move_to_end is true the article is appended after a comma instead, giving 'Matrix, The'. Titles without a leading article are returned trimmed but otherwise unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The title to strip",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "move_to_end",
+			Description: "Optional flag to move the article to the end after a comma instead of removing it, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripArticleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var moveToEnds []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &moveToEnds))
+	if resp.Error != nil {
+		return
+	}
+
+	moveToEnd, funcErr := optionalArgument(moveToEnds, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := strings.TrimSpace(input)
+	if m := leadingArticle.FindStringSubmatch(result); m != nil {
+		result = m[2]
+		if moveToEnd {
+			result += ", " + m[1]
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// optionsArgument returns the attributes of an optional trailing options object, which is
+// implemented as a dynamic variadic parameter at position. Attributes other than names are
+// rejected so that typos do not go unnoticed.
+func optionsArgument(values []types.Dynamic, position int64, names ...string) (map[string]attr.Value, *function.FuncError) {
+	value, funcErr := optionalArgument(values, position, types.DynamicNull())
+	if funcErr != nil {
+		return nil, funcErr
+	}
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return map[string]attr.Value{}, nil
+	}
+
+	object, ok := value.UnderlyingValue().(types.Object)
+	if !ok {
+		return nil, function.NewArgumentFuncError(position, "options must be an object, e.g. { trim = false }")
+	}
+	options := object.Attributes()
+	for name := range options {
+		if !slices.Contains(names, name) {
+			return nil, function.NewArgumentFuncError(position, fmt.Sprintf("unknown option %q, expected one of: %s", name, strings.Join(names, ", ")))
+		}
+	}
+	return options, nil
+}
+
+// boolOption returns the named bool option from options, or def when it was not given
+func boolOption(options map[string]attr.Value, name string, position int64, def bool) (bool, *function.FuncError) {
+	value, ok := options[name]
+	if !ok || value.IsNull() {
+		return def, nil
+	}
+
+	b, ok := value.(types.Bool)
+	if !ok {
+		return def, function.NewArgumentFuncError(position, fmt.Sprintf("option %q must be a bool", name))
+	}
+	return b.ValueBool(), nil
+}
+
+// stringOption returns the named string option from options, or def when it was not given
+func stringOption(options map[string]attr.Value, name string, position int64, def string) (string, *function.FuncError) {
+	value, ok := options[name]
+	if !ok || value.IsNull() {
+		return def, nil
+	}
+
+	str, ok := value.(types.String)
+	if !ok {
+		return def, function.NewArgumentFuncError(position, fmt.Sprintf("option %q must be a string", name))
+	}
+	return str.ValueString(), nil
+}
+
+// stringListOption returns the named list of strings option from options, or nil when it was not given
+func stringListOption(options map[string]attr.Value, name string, position int64) ([]string, *function.FuncError) {
+	value, ok := options[name]
+	if !ok || value.IsNull() {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.Tuple:
+		elements = v.Elements()
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	default:
+		return nil, function.NewArgumentFuncError(position, fmt.Sprintf("option %q must be a list of strings", name))
+	}
+
+	result := make([]string, len(elements))
+	for i, element := range elements {
+		str, ok := element.(types.String)
+		if !ok || str.IsNull() {
+			return nil, function.NewArgumentFuncError(position, fmt.Sprintf("option %q must be a list of strings", name))
+		}
+		result[i] = str.ValueString()
+	}
+	return result, nil
+}
+
+var (
+	// repeatedSpaces matches runs of horizontal whitespace
+	repeatedSpaces = regexp.MustCompile(`[ \t]+`)
+	// spaceBeforePunctuation matches whitespace in front of closing punctuation that ends a word,
+	// so that the '.' in ".5" or ".gitignore" is left alone
+	spaceBeforePunctuation = regexp.MustCompile(`[ \t]+([,.;:!?]+)(\s|$)`)
+	// missingSpaceAfterPunctuation matches a comma or semicolon directly followed by a letter
+	missingSpaceAfterPunctuation = regexp.MustCompile(`([,;])(\pL)`)
+)
+
+// TidyProseFunction cleans up spacing in prose
+var _ function.Function = &TidyProseFunction{}
+
+type TidyProseFunction struct{}
+
+func NewTidyProseFunction() function.Function {
+	return &TidyProseFunction{}
+}
+
+func (f *TidyProseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tidy_prose"
+}
+
+func (f *TidyProseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Clean up spacing in prose",
+		Description: "Tidies messy text in one call. Runs of spaces and tabs are collapsed to a single space (collapse_spaces), spaces before ',', '.', ';', ':', '!' and '?' are removed when the punctuation ends a word, so '.5' is kept, and a space is added after a comma or semicolon that is directly followed by a letter (fix_punctuation), and leading and trailing whitespace is removed (trim). Line breaks are kept. Each step is enabled by default and can be turned off with the optional options object, e.g. { trim = false }.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The text to tidy",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object with the bool attributes collapse_spaces, fix_punctuation and trim, each defaulting to true",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TidyProseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	options, funcErr := optionsArgument(optionValues, 1, "collapse_spaces", "fix_punctuation", "trim")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	collapseSpaces, funcErr := boolOption(options, "collapse_spaces", 1, true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	fixPunctuation, funcErr := boolOption(options, "fix_punctuation", 1, true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	trim, funcErr := boolOption(options, "trim", 1, true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := input
+	if collapseSpaces {
+		result = repeatedSpaces.ReplaceAllString(result, " ")
+	}
+	if fixPunctuation {
+		result = missingSpaceAfterPunctuation.ReplaceAllString(result, "$1 $2")
+		result = spaceBeforePunctuation.ReplaceAllString(result, "$1$2")
+	}
+	if trim {
+		result = strings.TrimSpace(result)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// graphemeClusters splits a string into extended grapheme clusters, the user-perceived
+// characters, so that an emoji sequence or a letter with combining marks is one element
+func graphemeClusters(s string) ([]string, error) {
+	tokens, err := textseg.AllTokens([]byte(s), textseg.ScanGraphemeClusters)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]string, len(tokens))
+	for i, token := range tokens {
+		clusters[i] = string(token)
+	}
+	return clusters, nil
+}
+
+// FitsGraphemeLimitFunction checks a string against a limit on user-perceived characters
+var _ function.Function = &FitsGraphemeLimitFunction{}
+
+type FitsGraphemeLimitFunction struct{}
+
+func NewFitsGraphemeLimitFunction() function.Function {
+	return &FitsGraphemeLimitFunction{}
+}
+
+func (f *FitsGraphemeLimitFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fits_grapheme_limit"
+}
+
+func (f *FitsGraphemeLimitFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check a string against a character limit counted in grapheme clusters",
+		Description: "Returns true if the string has at most max grapheme clusters. Grapheme clusters are user-perceived characters, so an emoji sequence such as a family or a flag counts as one character even though it is made of several code points, matching how platforms with character limits count.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to check",
+			},
+			function.Int64Parameter{
+				Name:        "max",
+				Description: "The maximum number of grapheme clusters",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *FitsGraphemeLimitFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var limit int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &limit))
+	if resp.Error != nil {
+		return
+	}
+
+	if limit < 0 {
+		resp.Error = function.NewArgumentFuncError(1, "max must not be negative")
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := int64(len(clusters)) <= limit
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// separatorRun matches a run of word separator characters
+var separatorRun = regexp.MustCompile(`[-_. /]+`)
+
+// CollapseAllSeparatorsFunction replaces mixed and repeated separators with a single one
+var _ function.Function = &CollapseAllSeparatorsFunction{}
+
+type CollapseAllSeparatorsFunction struct{}
+
+func NewCollapseAllSeparatorsFunction() function.Function {
+	return &CollapseAllSeparatorsFunction{}
+}
+
+func (f *CollapseAllSeparatorsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "collapse_all_separators"
+}
+
+func (f *CollapseAllSeparatorsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Replace runs of separators with a single separator",
+		Description: "Replaces every run of the separator characters '_', '-', '.', '/' and space with a single target separator, leaving the words and their case untouched. Separators at the start or end are collapsed too, not removed. For example, with target '-': 'foo__bar--baz' becomes 'foo-bar-baz'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to clean up",
+			},
+			function.StringParameter{
+				Name:        "target",
+				Description: "The separator to use in place of each run",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CollapseAllSeparatorsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var target string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	result := separatorRun.ReplaceAllLiteralString(input, target)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// FormatPhoneFunction formats the digits of a phone number according to a pattern
+var _ function.Function = &FormatPhoneFunction{}
+
+type FormatPhoneFunction struct{}
+
+func NewFormatPhoneFunction() function.Function {
+	return &FormatPhoneFunction{}
+}
+
+func (f *FormatPhoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_phone"
+}
+
+func (f *FormatPhoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Format a phone number using a pattern",
+		Description: "Fills each '#' in the pattern with the next digit of the input, keeping all other pattern characters as they are. Non-digit characters in the input are ignored. Digits left over once the pattern is full are appended at the end, and placeholders left over once the digits run out become spaces so the layout keeps its width. For example: '5551234567' with '(###) ###-####' becomes '(555) 123-4567'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The phone number digits",
+			},
+			function.StringParameter{
+				Name:        "pattern",
+				Description: "The pattern to fill, with '#' for each digit, e.g. '(###) ###-####'",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatPhoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var pattern string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	var digits []rune
+	for _, r := range input {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+
+	var result strings.Builder
+	for _, r := range pattern {
+		switch {
+		case r != '#':
+			result.WriteRune(r)
+		case len(digits) > 0:
+			result.WriteRune(digits[0])
+			digits = digits[1:]
+		default:
+			result.WriteRune(' ')
+		}
+	}
+	result.WriteString(string(digits))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// invalidDNSLabelChars matches runs of characters that are not allowed in a DNS label
+var invalidDNSLabelChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// dnsLabel normalizes a string into a DNS label: latinized, lowercase, with each run of
+// characters other than letters and digits replaced by a hyphen, no leading or trailing
+// hyphens and at most 63 characters
+func dnsLabel(input string) (string, error) {
+	latinized, err := latinize(input)
+	if err != nil {
+		return "", err
+	}
+
+	label := invalidDNSLabelChars.ReplaceAllString(strings.ToLower(latinized), "-")
+	label = strings.Trim(label, "-")
+	if len(label) > 63 {
+		label = strings.TrimRight(label[:63], "-")
+	}
+	return label, nil
+}
+
+// DnsHostnameFunction normalizes a string into a multi-label DNS hostname
+var _ function.Function = &DnsHostnameFunction{}
+
+type DnsHostnameFunction struct{}
+
+func NewDnsHostnameFunction() function.Function {
+	return &DnsHostnameFunction{}
+}
+
+func (f *DnsHostnameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_hostname"
+}
+
+func (f *DnsHostnameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a string into a DNS hostname",
+		Description: "Splits the input on dots and normalizes each label: latinized, lowercased, with each run of characters other than letters and digits replaced by a hyphen, leading and trailing hyphens removed and truncated to 63 characters. Empty labels are dropped and the rest are joined with dots. Returns an error if the resulting hostname is longer than 253 characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The hostname to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DnsHostnameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	var labels []string
+	for _, segment := range strings.Split(input, ".") {
+		label, err := dnsLabel(segment)
+		if err != nil {
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+		if label != "" {
+			labels = append(labels, label)
+		}
+	}
+
+	result := strings.Join(labels, ".")
+	if len(result) > 253 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("hostname is %d characters long, the maximum is 253", len(result)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// NfkcFunction applies Unicode NFKC compatibility normalization
+var _ function.Function = &NfkcFunction{}
+
+type NfkcFunction struct{}
+
+func NewNfkcFunction() function.Function {
+	return &NfkcFunction{}
+}
+
+func (f *NfkcFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nfkc"
+}
+
+func (f *NfkcFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Apply NFKC compatibility normalization",
+		Description: "Normalizes the string to Unicode Normalization Form KC, replacing compatibility characters with their standard equivalents: ligatures such as 'ﬁ' are expanded, fullwidth letters and digits become their ordinary forms and symbols such as '№' are spelled out. For example: 'ﬁle' becomes 'file' and '２０２４' becomes '2024'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NfkcFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result := norm.NFKC.String(input)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// normalizationForms maps the names accepted by NormalizeFunction to their Unicode normalization forms
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// NormalizeFunction normalizes a string to a selectable Unicode normalization form
+var _ function.Function = &NormalizeFunction{}
+
+type NormalizeFunction struct{}
+
+func NewNormalizeFunction() function.Function {
+	return &NormalizeFunction{}
+}
+
+func (f *NormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize"
+}
+
+func (f *NormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a string to a Unicode normalization form",
+		Description: "Normalizes the string to the given Unicode normalization form: 'NFC', 'NFD', 'NFKC' or 'NFKD' (in any case). Note that Terraform itself stores every string in NFC, so decomposed results from 'NFD' and 'NFKD' are recomposed when Terraform receives them: 'NFD' behaves like 'NFC' and 'NFKD' like 'NFKC' in practice.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "form",
+				Description: "The normalization form: 'NFC', 'NFD', 'NFKC' or 'NFKD'",
+			},
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var form string
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &form, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	normForm, ok := normalizationForms[strings.ToUpper(form)]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unknown normalization form %q, expected one of: NFC, NFD, NFKC, NFKD", form))
+		return
+	}
+
+	result := normForm.String(input)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// cyrillicToLatin is the romanization of lowercase Cyrillic letters used by CyrillicToLatinFunction,
+// following the BGN/PCGN system for Russian without diacritics. The hard and soft signs are dropped.
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g",
+}
+
+// cyrillicVariant holds the per-language differences from cyrillicToLatin
+type cyrillicVariant struct {
+	// letters overrides the romanization of letters anywhere in a word
+	letters map[rune]string
+	// initials overrides the romanization of letters at the start of a word
+	initials map[rune]string
+	// dropApostrophes drops apostrophes between two Cyrillic letters, as in "м’ята", without
+	// starting a new word there
+	dropApostrophes bool
+}
+
+// isInnerApostrophe reports whether lower[i] is an apostrophe between two Cyrillic letters
+func isInnerApostrophe(lower []rune, i int) bool {
+	switch lower[i] {
+	case '\'', '’', 'ʼ':
+		return i > 0 && i+1 < len(lower) && unicode.Is(unicode.Cyrillic, lower[i-1]) && unicode.Is(unicode.Cyrillic, lower[i+1])
+	}
+	return false
+}
+
+// cyrillicVariants maps variant names to their differences from cyrillicToLatin. Ukrainian
+// follows the official 2010 national romanization, where the iotated vowels are spelled with
+// a 'y' only at the start of a word and apostrophes inside words are dropped.
+var cyrillicVariants = map[string]cyrillicVariant{
+	"ru": {},
+	"uk": {
+		letters: map[rune]string{
+			'г': "h", 'и': "y", 'є': "ie", 'ї': "i", 'й': "i", 'ю': "iu", 'я': "ia",
+		},
+		initials: map[rune]string{
+			'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+		},
+		dropApostrophes: true,
+	},
+}
+
+// transliterate replaces each rune for which romanize returns a Latin spelling, keeping the
+// case of the original: an uppercase letter becomes all caps inside an uppercase word and is
+// capitalized otherwise, so "Щука" becomes "Shchuka" and "ЩУКА" becomes "SHCHUKA". romanize
+// is given the lowercase runes of the input and the index of the rune to spell.
+func transliterate(input string, romanize func(lower []rune, i int) (string, bool)) string {
+	rs := []rune(input)
+	lower := make([]rune, len(rs))
+	for i, r := range rs {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var result strings.Builder
+	for i, r := range rs {
+		latin, ok := romanize(lower, i)
+		if !ok {
+			result.WriteRune(r)
+			continue
+		}
+		if !unicode.IsUpper(r) {
+			result.WriteString(latin)
+			continue
+		}
+
+		nextUpper := i+1 < len(rs) && unicode.IsUpper(rs[i+1])
+		prevUpper := i > 0 && unicode.IsUpper(rs[i-1])
+		nextLetter := i+1 < len(rs) && unicode.IsLetter(rs[i+1])
+		if nextUpper || (prevUpper && !nextLetter) {
+			result.WriteString(strings.ToUpper(latin))
+		} else {
+			result.WriteString(titleWord(latin, caseOptions{}))
+		}
+	}
+	return result.String()
+}
+
+// CyrillicToLatinFunction transliterates Cyrillic text to Latin letters
+var _ function.Function = &CyrillicToLatinFunction{}
+
+type CyrillicToLatinFunction struct{}
+
+func NewCyrillicToLatinFunction() function.Function {
+	return &CyrillicToLatinFunction{}
+}
+
+func (f *CyrillicToLatinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cyrillic_to_latin"
+}
+
+func (f *CyrillicToLatinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Transliterate Cyrillic to Latin letters",
+		Description: "Transliterates Russian or Ukrainian Cyrillic letters to plain Latin letters, for example 'ж' to 'zh' and 'щ' to 'shch', keeping upper and lower case. The hard and soft signs are dropped and all other characters are kept as they are. The 'ru' variant follows a simplified BGN/PCGN system for Russian: 'Привет' becomes 'Privet'. The 'uk' variant follows the Ukrainian national system, where for example 'г' is 'h' and 'и' is 'y' and apostrophes between Cyrillic letters are dropped: 'Київ' becomes 'Kyiv' and 'м’ята' becomes 'miata'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to transliterate",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "variant",
+			Description: "Optional language variant, 'ru' or 'uk', defaults to 'ru'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CyrillicToLatinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var variants []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &variants))
+	if resp.Error != nil {
+		return
+	}
+
+	variant, funcErr := optionalArgument(variants, 1, "ru")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	overrides, ok := cyrillicVariants[variant]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unknown variant %q, expected one of: ru, uk", variant))
+		return
+	}
+
+	letters := maps.Clone(cyrillicToLatin)
+	maps.Copy(letters, overrides.letters)
+	result := transliterate(input, func(lower []rune, i int) (string, bool) {
+		if overrides.dropApostrophes && isInnerApostrophe(lower, i) {
+			return "", true
+		}
+		wordStart := i == 0 || (!unicode.IsLetter(lower[i-1]) && !(overrides.dropApostrophes && isInnerApostrophe(lower, i-1)))
+		if initial, ok := overrides.initials[lower[i]]; ok && wordStart {
+			return initial, true
+		}
+		latin, ok := letters[lower[i]]
+		return latin, ok
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// greekToLatin is the romanization of lowercase Greek letters used by GreekToLatinFunction,
+// following ELOT 743. Letters that depend on their neighbours are handled in romanizeGreek.
+var greekToLatin = map[rune]string{
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+	'ω': "o",
+}
+
+// romanizeGreek spells the Greek letter at lower[i], given the lowercase letters with their
+// accents removed. diaeresis reports whether the original letter had a diaeresis, which
+// marks a vowel that does not form a digraph with the one before it.
+func romanizeGreek(lower []rune, i int, diaeresis bool) (string, bool) {
+	var prev, next rune
+	if i > 0 {
+		prev = lower[i-1]
+	}
+	if i+1 < len(lower) {
+		next = lower[i+1]
+	}
+
+	switch {
+	case lower[i] == 'υ' && !diaeresis && prev == 'ο':
+		return "u", true
+	case lower[i] == 'υ' && !diaeresis && (prev == 'α' || prev == 'ε' || prev == 'η'):
+		// αυ, ευ and ηυ are voiceless before a voiceless consonant or at the end of a word
+		if _, letter := greekToLatin[next]; !letter || strings.ContainsRune("θκξπστφχψ", next) {
+			return "f", true
+		}
+		return "v", true
+	case lower[i] == 'γ' && (next == 'γ' || next == 'ξ' || next == 'χ'):
+		return "n", true
+	}
+	latin, ok := greekToLatin[lower[i]]
+	return latin, ok
+}
+
+// GreekToLatinFunction transliterates Greek text to Latin letters
+var _ function.Function = &GreekToLatinFunction{}
+
+type GreekToLatinFunction struct{}
+
+func NewGreekToLatinFunction() function.Function {
+	return &GreekToLatinFunction{}
+}
+
+func (f *GreekToLatinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "greek_to_latin"
+}
+
+func (f *GreekToLatinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Transliterate Greek to Latin letters",
+		Description: "Transliterates Greek letters to plain Latin letters following ELOT 743, for example 'θ' to 'th', 'χ' to 'ch', 'ψ' to 'ps' and 'ου' to 'ou', and 'αυ' and 'ευ' to 'av' and 'ev', or to 'af' and 'ef' before a voiceless consonant or at the end of a word, keeping upper and lower case. Accents such as the tonos are removed by latinizing the Greek letters first. All other characters are kept as they are. For example: 'Αθήνα' becomes 'Athina'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to transliterate",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GreekToLatinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	// Latinize each Greek letter on its own to drop its accents, leaving other scripts alone
+	rs := []rune(input)
+	diaeresis := make([]bool, len(rs))
+	for i, r := range rs {
+		if !unicode.Is(unicode.Greek, r) {
+			continue
+		}
+		decomposed := norm.NFD.String(string(r))
+		diaeresis[i] = strings.ContainsRune(decomposed, '\u0308')
+
+		latinized, err := latinize(string(r))
+		if err != nil {
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+		rs[i], _ = utf8.DecodeRuneInString(latinized)
+	}
+
+	result := transliterate(string(rs), func(lower []rune, i int) (string, bool) {
+		return romanizeGreek(lower, i, diaeresis[i])
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// emojiRunes holds the runes that make a grapheme cluster an emoji: the characters with the
+// Emoji_Presentation property in emoji-data.txt of Unicode 15.0.0
+// (https://www.unicode.org/Public/15.0.0/ucd/emoji/emoji-data.txt), which include the regional
+// indicators and skin tone modifiers, plus the keycap mark U+20E3 and the emoji variation
+// selector U+FE0F. Emoji that default to text presentation, such as '©' and '↔', and
+// pictographs that are not emoji, such as '★', only count when followed by the variation selector.
+var emojiRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x20e3, 0x20e3, 1},
+		{0x231a, 0x231b, 1},
+		{0x23e9, 0x23ec, 1},
+		{0x23f0, 0x23f0, 1},
+		{0x23f3, 0x23f3, 1},
+		{0x25fd, 0x25fe, 1},
+		{0x2614, 0x2615, 1},
+		{0x2648, 0x2653, 1},
+		{0x267f, 0x267f, 1},
+		{0x2693, 0x2693, 1},
+		{0x26a1, 0x26a1, 1},
+		{0x26aa, 0x26ab, 1},
+		{0x26bd, 0x26be, 1},
+		{0x26c4, 0x26c5, 1},
+		{0x26ce, 0x26ce, 1},
+		{0x26d4, 0x26d4, 1},
+		{0x26ea, 0x26ea, 1},
+		{0x26f2, 0x26f3, 1},
+		{0x26f5, 0x26f5, 1},
+		{0x26fa, 0x26fa, 1},
+		{0x26fd, 0x26fd, 1},
+		{0x2705, 0x2705, 1},
+		{0x270a, 0x270b, 1},
+		{0x2728, 0x2728, 1},
+		{0x274c, 0x274c, 1},
+		{0x274e, 0x274e, 1},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2795, 0x2797, 1},
+		{0x27b0, 0x27b0, 1},
+		{0x27bf, 0x27bf, 1},
+		{0x2b1b, 0x2b1c, 1},
+		{0x2b50, 0x2b50, 1},
+		{0x2b55, 0x2b55, 1},
+		{0xfe0f, 0xfe0f, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f004, 0x1f004, 1},
+		{0x1f0cf, 0x1f0cf, 1},
+		{0x1f18e, 0x1f18e, 1},
+		{0x1f191, 0x1f19a, 1},
+		{0x1f1e6, 0x1f1ff, 1},
+		{0x1f201, 0x1f201, 1},
+		{0x1f21a, 0x1f21a, 1},
+		{0x1f22f, 0x1f22f, 1},
+		{0x1f232, 0x1f236, 1},
+		{0x1f238, 0x1f23a, 1},
+		{0x1f250, 0x1f251, 1},
+		{0x1f300, 0x1f320, 1},
+		{0x1f32d, 0x1f335, 1},
+		{0x1f337, 0x1f37c, 1},
+		{0x1f37e, 0x1f393, 1},
+		{0x1f3a0, 0x1f3ca, 1},
+		{0x1f3cf, 0x1f3d3, 1},
+		{0x1f3e0, 0x1f3f0, 1},
+		{0x1f3f4, 0x1f3f4, 1},
+		{0x1f3f8, 0x1f43e, 1},
+		{0x1f440, 0x1f440, 1},
+		{0x1f442, 0x1f4fc, 1},
+		{0x1f4ff, 0x1f53d, 1},
+		{0x1f54b, 0x1f54e, 1},
+		{0x1f550, 0x1f567, 1},
+		{0x1f57a, 0x1f57a, 1},
+		{0x1f595, 0x1f596, 1},
+		{0x1f5a4, 0x1f5a4, 1},
+		{0x1f5fb, 0x1f64f, 1},
+		{0x1f680, 0x1f6c5, 1},
+		{0x1f6cc, 0x1f6cc, 1},
+		{0x1f6d0, 0x1f6d2, 1},
+		{0x1f6d5, 0x1f6d7, 1},
+		{0x1f6dc, 0x1f6df, 1},
+		{0x1f6eb, 0x1f6ec, 1},
+		{0x1f6f4, 0x1f6fc, 1},
+		{0x1f7e0, 0x1f7eb, 1},
+		{0x1f7f0, 0x1f7f0, 1},
+		{0x1f90c, 0x1f93a, 1},
+		{0x1f93c, 0x1f945, 1},
+		{0x1f947, 0x1f9ff, 1},
+		{0x1fa70, 0x1fa7c, 1},
+		{0x1fa80, 0x1fa88, 1},
+		{0x1fa90, 0x1fabd, 1},
+		{0x1fabf, 0x1fac5, 1},
+		{0x1face, 0x1fadb, 1},
+		{0x1fae0, 0x1fae8, 1},
+		{0x1faf0, 0x1faf8, 1},
+	},
+}
+
+// isEmojiCluster reports whether a grapheme cluster contains any rune of emojiRunes
+func isEmojiCluster(cluster string) bool {
+	return strings.ContainsFunc(cluster, func(r rune) bool {
+		return unicode.Is(emojiRunes, r)
+	})
+}
+
+// StripEmojiFunction removes emoji from a string
+var _ function.Function = &StripEmojiFunction{}
+
+type StripEmojiFunction struct{}
+
+func NewStripEmojiFunction() function.Function {
+	return &StripEmojiFunction{}
+}
+
+func (f *StripEmojiFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_emoji"
+}
+
+func (f *StripEmojiFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove emoji",
+		Description: "Removes emoji, treating each grapheme cluster as a unit so that skin tone modifiers, zero-width joiner sequences such as families, flags and keycaps are removed whole. Surrounding whitespace is kept. Only characters shown as emoji by default, those with the Unicode Emoji_Presentation property, are removed: symbols that usually appear as text, such as '©', '™', '↔' and '★', are kept unless they carry the emoji variation selector. For example: 'hello 👋🏽 world 🎉' becomes 'hello  world '.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to strip",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripEmojiFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	var result strings.Builder
+	for _, cluster := range clusters {
+		if !isEmojiCluster(cluster) {
+			result.WriteString(cluster)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// LengthFunction counts the user-perceived characters of a string
+var _ function.Function = &LengthFunction{}
+
+type LengthFunction struct{}
+
+func NewLengthFunction() function.Function {
+	return &LengthFunction{}
+}
+
+func (f *LengthFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "length"
+}
+
+func (f *LengthFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count grapheme clusters",
+		Description: "Returns the number of grapheme clusters (user-perceived characters) in the string, as defined by Unicode UAX #29. A letter with combining marks, an emoji sequence joined with zero-width joiners and a CRLF line break each count as one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to measure",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *LengthFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(len(clusters))))
+}
+
+// ReverseFunction reverses the user-perceived characters of a string
+var _ function.Function = &ReverseFunction{}
+
+type ReverseFunction struct{}
+
+func NewReverseFunction() function.Function {
+	return &ReverseFunction{}
+}
+
+func (f *ReverseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse"
+}
+
+func (f *ReverseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Reverse a string by grapheme clusters",
+		Description: "Reverses the order of the grapheme clusters (user-perceived characters) in the string, so combining marks stay on their base letter and emoji sequences stay intact. For example: 'a👨‍👩‍👧b' becomes 'b👨‍👩‍👧a'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to reverse",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ReverseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	slices.Reverse(clusters)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(clusters, "")))
+}
+
+// toSlug converts input to lowercase ASCII words joined by separator. When maxLength is
+// greater than zero, whole words are dropped from the end until the slug fits, and a first
+// word that is longer than maxLength on its own is cut short.
+func toSlug(input string, separator string, maxLength int) (string, error) {
+	latinized, err := latinize(input)
+	if err != nil {
+		return "", err
+	}
+
+	words := splitCaseWords(stripNonASCII(asciiExpansions.Replace(latinized)), caseOptions{})
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+	if maxLength <= 0 || len(words) == 0 {
+		return strings.Join(words, separator), nil
+	}
+
+	if len(words[0]) > maxLength {
+		return words[0][:maxLength], nil
+	}
+	result := words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(result+separator+word) > maxLength {
+			break
+		}
+		result += separator + word
+	}
+	return result, nil
+}
+
+// SlugifyFunction converts a string to a URL slug
+var _ function.Function = &SlugifyFunction{}
+
+type SlugifyFunction struct{}
+
+func NewSlugifyFunction() function.Function {
+	return &SlugifyFunction{}
+}
+
+func (f *SlugifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *SlugifyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to a URL slug",
+		Description: "Converts to a slug of lowercase ASCII words, like kebab but transliterating letters such as 'ß' to 'ss' instead of dropping them. Optionally takes a maximum length and a separator, in that order. A slug longer than the maximum is cut back to the last whole word that fits, so it never ends in part of a word or a separator. For example: 'Héllo, World! 123' becomes 'hello-world-123'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:           "max_length_and_separator",
+			AllowNullValue: true,
+			Description:    "Optional maximum length, greater than zero or null for no limit, followed by an optional separator, defaults to '-'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SlugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var args []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(args) > 2 {
+		resp.Error = function.NewArgumentFuncError(3, "too many arguments: at most a maximum length and a separator may be given")
+		return
+	}
+
+	maxLength := 0
+	if len(args) > 0 && !args[0].IsNull() && !args[0].IsUnderlyingValueNull() {
+		number, ok := args[0].UnderlyingValue().(types.Number)
+		if !ok {
+			resp.Error = function.NewArgumentFuncError(1, "max_length must be a number")
+			return
+		}
+		value, accuracy := number.ValueBigFloat().Int64()
+		if accuracy != big.Exact || value <= 0 {
+			resp.Error = function.NewArgumentFuncError(1, "max_length must be a whole number greater than zero")
+			return
+		}
+		maxLength = int(value)
+	}
+
+	separator := "-"
+	if len(args) > 1 && !args[1].IsNull() && !args[1].IsUnderlyingValueNull() {
+		str, ok := args[1].UnderlyingValue().(types.String)
+		if !ok {
+			resp.Error = function.NewArgumentFuncError(2, "separator must be a string")
+			return
+		}
+		separator = str.ValueString()
+	}
+
+	result, err := toSlug(input, separator, maxLength)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// isSpaceCluster reports whether a grapheme cluster starts with a whitespace character
+func isSpaceCluster(cluster string) bool {
+	r, _ := utf8.DecodeRuneInString(cluster)
+	return unicode.IsSpace(r)
+}
+
+// TruncateFunction shortens a string to a maximum number of grapheme clusters
+var _ function.Function = &TruncateFunction{}
+
+type TruncateFunction struct{}
+
+func NewTruncateFunction() function.Function {
+	return &TruncateFunction{}
+}
+
+func (f *TruncateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "truncate"
+}
+
+func (f *TruncateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Truncate a string with an ellipsis",
+		Description: "Shortens the string to at most max_length grapheme clusters (user-perceived characters), including the ellipsis that marks the cut. The cut moves back to the end of the last whole word when it would fall inside a word, unless the first word alone is too long. A string that already fits is returned unchanged. For example: 'Hello World' with a max_length of 8 becomes 'Hello…'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to truncate",
+			},
+			function.Int64Parameter{
+				Name:        "max_length",
+				Description: "The maximum number of grapheme clusters in the result, must be at least the length of the ellipsis",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "ellipsis",
+			Description: "Optional string appended where the input was cut, defaults to '…'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TruncateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var maxLength int64
+	var ellipses []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &maxLength, &ellipses))
+	if resp.Error != nil {
+		return
+	}
+
+	ellipsis, funcErr := optionalArgument(ellipses, 2, "…")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	ellipsisClusters, err := graphemeClusters(ellipsis)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	if maxLength < int64(len(ellipsisClusters)) {
+		resp.Error = function.NewArgumentFuncError(1, "max_length must be at least the length of the ellipsis")
+		return
+	}
+
+	if int64(len(clusters)) <= maxLength {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, input))
+		return
+	}
+
+	keep := int(maxLength) - len(ellipsisClusters)
+	if !isSpaceCluster(clusters[keep]) {
+		for i := keep - 1; i > 0; i-- {
+			if isSpaceCluster(clusters[i]) {
+				keep = i
+				break
+			}
+		}
+	}
+	for keep > 0 && isSpaceCluster(clusters[keep-1]) {
+		keep--
+	}
+
+	result := strings.Join(clusters[:keep], "") + ellipsis
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// padClusters returns count grapheme clusters of padding, repeating the clusters of pad
+func padClusters(pad []string, count int) string {
+	var result strings.Builder
+	for i := range count {
+		result.WriteString(pad[i%len(pad)])
+	}
+	return result.String()
+}
+
+// CenterFunction pads a string on both sides to center it
+var _ function.Function = &CenterFunction{}
+
+type CenterFunction struct{}
+
+func NewCenterFunction() function.Function {
+	return &CenterFunction{}
+}
+
+func (f *CenterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "center"
+}
+
+func (f *CenterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Center a string by padding both sides",
+		Description: "Pads the string on both sides to width grapheme clusters (user-perceived characters). When the total padding is odd, the extra character goes on the right. A string that is already at least width long is returned unchanged. For example: 'hi' with a width of 6 and a pad of '*' becomes '**hi**'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to center",
+			},
+			function.Int64Parameter{
+				Name:        "width",
+				Description: "The width to pad to, in grapheme clusters",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "pad",
+			Description: "Optional non-empty string to pad with, repeated as needed, defaults to a space",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CenterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var width int64
+	var pads []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &width, &pads))
+	if resp.Error != nil {
+		return
+	}
+
+	pad, funcErr := optionalArgument(pads, 2, " ")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if pad == "" {
+		resp.Error = function.NewArgumentFuncError(2, "pad must not be empty")
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	padding, err := graphemeClusters(pad)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	total := max(int(width)-len(clusters), 0)
+	left := total / 2
+	result := padClusters(padding, left) + input + padClusters(padding, total-left)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// maxRepeatLength is the largest result, in bytes, that RepeatFunction will build
+const maxRepeatLength = 1 << 20
+
+// RepeatFunction repeats a string a number of times
+var _ function.Function = &RepeatFunction{}
+
+type RepeatFunction struct{}
+
+func NewRepeatFunction() function.Function {
+	return &RepeatFunction{}
+}
+
+func (f *RepeatFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "repeat"
+}
+
+func (f *RepeatFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Repeat a string",
+		Description: "Returns the string repeated count times, or an empty string for a count of zero. To guard against accidentally huge values, the result may be at most 1 MiB (1048576 bytes). For example: 'ab' with a count of 3 becomes 'ababab'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to repeat",
+			},
+			function.Int64Parameter{
+				Name:        "count",
+				Description: "The number of times to repeat the string, must not be negative",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RepeatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var count int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	if count < 0 {
+		resp.Error = function.NewArgumentFuncError(1, "count must not be negative")
+		return
+	}
+	if len(input) > 0 && count > maxRepeatLength/int64(len(input)) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("result would be %d repetitions of %d bytes, more than the limit of %d bytes", count, len(input), maxRepeatLength))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Repeat(input, int(count))))
+}
+
+// WordCountFunction counts the words in a string
+var _ function.Function = &WordCountFunction{}
+
+type WordCountFunction struct{}
+
+func NewWordCountFunction() function.Function {
+	return &WordCountFunction{}
+}
+
+func (f *WordCountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "word_count"
+}
+
+func (f *WordCountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count the words in a string",
+		Description: "Returns the number of words, where a word is a run of letters, digits and combining marks in any script and everything else separates words. Empty or punctuation-only input has zero words. For example: 'Hello, world! Foo' has 3 words.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to count words in",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *WordCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	count := int64(len(strings.FieldsFunc(input, isNotWordRune)))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, count))
+}
+
+// CharCountFunction counts the user-perceived characters in a string
+var _ function.Function = &CharCountFunction{}
+
+type CharCountFunction struct{}
+
+func NewCharCountFunction() function.Function {
+	return &CharCountFunction{}
+}
+
+func (f *CharCountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "char_count"
+}
+
+func (f *CharCountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count user-perceived characters",
+		Description: "Returns the number of characters as a reader would count them, which is the number of grapheme clusters rather than bytes or code points. This is the count used by character limits on social media posts. For example: 'héllo' has 5 characters even when the 'é' is written as 'e' plus a combining accent.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to count characters in",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *CharCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(len(clusters))))
+}
+
+// LineCountFunction counts the lines in a string
+var _ function.Function = &LineCountFunction{}
+
+type LineCountFunction struct{}
+
+func NewLineCountFunction() function.Function {
+	return &LineCountFunction{}
+}
+
+func (f *LineCountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "line_count"
+}
+
+func (f *LineCountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count the lines in a string",
+		Description: "Returns the number of lines, split on '\\n' with '\\r\\n' counting as a single line break. A newline at the very end finishes the last line rather than starting an empty one, as in a heredoc, unless count_trailing_empty is true. An empty string has zero lines. For example: 'a\\nb\\nc\\n' has 3 lines.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to count lines in",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "count_trailing_empty",
+			Description: "Optional flag to count the empty line after a trailing newline, defaults to false",
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *LineCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var countTrailingEmpties []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &countTrailingEmpties))
+	if resp.Error != nil {
+		return
+	}
+
+	countTrailingEmpty, funcErr := optionalArgument(countTrailingEmpties, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	var count int64
+	if input != "" {
+		count = int64(strings.Count(input, "\n")) + 1
+		if strings.HasSuffix(input, "\n") && !countTrailingEmpty {
+			count--
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, count))
+}
+
+// ToCharsFunction splits a string into its user-perceived characters
+var _ function.Function = &ToCharsFunction{}
+
+type ToCharsFunction struct{}
+
+func NewToCharsFunction() function.Function {
+	return &ToCharsFunction{}
+}
+
+func (f *ToCharsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_chars"
+}
+
+func (f *ToCharsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a string into grapheme clusters",
+		Description: "Returns the user-perceived characters of the string as a list, in order. Each element is one grapheme cluster, so a letter keeps its combining marks and an emoji sequence joined with zero-width joiners stays whole. For example: 'café' becomes ['c', 'a', 'f', 'é'].",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to split",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ToCharsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, clusters))
+}
+
+// WordsFunction returns the words that the case conversion functions split a string into
+var _ function.Function = &WordsFunction{}
+
+type WordsFunction struct{}
+
+func NewWordsFunction() function.Function {
+	return &WordsFunction{}
+}
+
+func (f *WordsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "words"
+}
+
+func (f *WordsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a string into words",
+		Description: "Returns the words that the word-based case conversion functions would use, splitting on characters that are not Latin letters or digits and at camelCase and acronym boundaries. The words keep their original spelling and case, without latinizing. For example: 'getHTTPResponse_v2' becomes ['get', 'HTTP', 'Response', 'v2'].",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to split",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object of options: split_digits (bool, defaults to false) also splits words between letters and digits",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *WordsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, funcErr := caseOptionsArgument(optionValues, 1, "split_digits")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	words := splitCaseWords(input, opts)
+	if words == nil {
+		words = []string{}
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, words))
+}
+
+// allCases holds the result of AllCasesFunction
+type allCases struct {
+	Flat   string `tfsdk:"flat"`
+	Kebab  string `tfsdk:"kebab"`
+	Camel  string `tfsdk:"camel"`
+	Pascal string `tfsdk:"pascal"`
+	Snake  string `tfsdk:"snake"`
+	Upper  string `tfsdk:"upper"`
+	Train  string `tfsdk:"train"`
+	Ada    string `tfsdk:"ada"`
+}
+
+// AllCasesFunction converts a string to every identifier case style at once
+var _ function.Function = &AllCasesFunction{}
+
+type AllCasesFunction struct{}
+
+func NewAllCasesFunction() function.Function {
+	return &AllCasesFunction{}
+}
+
+func (f *AllCasesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "all_cases"
+}
+
+func (f *AllCasesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to every identifier case style",
+		Description: "Returns an object with the input converted to each identifier case style, under the attributes 'flat', 'kebab', 'camel', 'pascal', 'snake', 'upper', 'train' and 'ada'. Each attribute holds the same result as the function of that name. The input is latinized and split into words once, and every style is built from the same words.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"flat":   types.StringType,
+				"kebab":  types.StringType,
+				"camel":  types.StringType,
+				"pascal": types.StringType,
+				"snake":  types.StringType,
+				"upper":  types.StringType,
+				"train":  types.StringType,
+				"ada":    types.StringType,
+			},
+		},
+	}
+}
+
+func (f *AllCasesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	opts := caseOptions{}
+	words, err := caseWords(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := allCases{
+		Flat:   joinFlat(words, opts),
+		Kebab:  joinKebab(words, opts),
+		Camel:  joinCamel(words, opts),
+		Pascal: joinPascal(words, opts),
+		Snake:  joinSnake(words, opts),
+		Upper:  joinUpper(words, opts),
+		Train:  joinTrain(words, opts),
+		Ada:    joinAda(words, opts),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// isBlankRune reports whether r is whitespace or an invisible zero-width space, including
+// no-break spaces and the other Unicode space separators
+func isBlankRune(r rune) bool {
+	switch r {
+	case '\u200B', '\u2060', '\uFEFF':
+		return true
+	}
+	return unicode.IsSpace(r) || unicode.In(r, unicode.Zs, unicode.Zl, unicode.Zp)
+}
+
+// isBlank reports whether s is empty or consists only of blank characters
+func isBlank(s string) bool {
+	return strings.TrimFunc(s, isBlankRune) == ""
+}
+
+// IsBlankFunction checks whether a string has no visible content
+var _ function.Function = &IsBlankFunction{}
+
+type IsBlankFunction struct{}
+
+func NewIsBlankFunction() function.Function {
+	return &IsBlankFunction{}
+}
+
+func (f *IsBlankFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_blank"
+}
+
+func (f *IsBlankFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether a string is blank",
+		Description: "Returns true if the string is empty or contains only whitespace. Whitespace includes tabs and line breaks, the no-break space and every other Unicode space separator, and the zero-width space, word joiner and byte order mark, which are invisible.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsBlankFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, isBlank(input)))
+}
+
+// CoalesceNonblankFunction returns the first argument that is not blank
+var _ function.Function = &CoalesceNonblankFunction{}
+
+type CoalesceNonblankFunction struct{}
+
+func NewCoalesceNonblankFunction() function.Function {
+	return &CoalesceNonblankFunction{}
+}
+
+func (f *CoalesceNonblankFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "coalesce_nonblank"
+}
+
+func (f *CoalesceNonblankFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the first non-blank string",
+		Description: "Returns the first argument that is not blank, unchanged. Unlike coalesce, strings made only of whitespace are skipped too, using the same definition of whitespace as is_blank. Returns an error if every argument is blank. For example: coalesce_nonblank(\"\", \"  \", \"real\", \"fallback\") returns 'real'.",
+		VariadicParameter: function.StringParameter{
+			Name:        "values",
+			Description: "The strings to choose from, in order of preference",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CoalesceNonblankFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	i := slices.IndexFunc(values, func(value string) bool { return !isBlank(value) })
+	if i < 0 {
+		resp.Error = function.NewFuncError("no non-blank argument: every value is empty or whitespace")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, values[i]))
+}
+
+// base64Encodings maps the variant names accepted by the base64 functions to their encodings
+var base64Encodings = map[string]*base64.Encoding{
+	"std":         base64.StdEncoding,
+	"urlsafe":     base64.URLEncoding,
+	"raw_std":     base64.RawStdEncoding,
+	"raw_urlsafe": base64.RawURLEncoding,
+}
+
+// lookupEncoding returns the encoding registered under name, or an argument error at
+// position listing the valid names, where kind describes the argument, e.g. "variant"
+func lookupEncoding[T any](encodings map[string]T, kind string, name string, position int64) (T, *function.FuncError) {
+	encoding, ok := encodings[name]
+	if !ok {
+		names := slices.Sorted(maps.Keys(encodings))
+		return encoding, function.NewArgumentFuncError(position, fmt.Sprintf("unknown %s %q, expected one of: %s", kind, name, strings.Join(names, ", ")))
+	}
+	return encoding, nil
+}
+
+// decodedString checks that decoded bytes form valid UTF-8, since Terraform strings cannot
+// hold arbitrary binary data
+func decodedString(decoded []byte) (string, *function.FuncError) {
+	if !utf8.Valid(decoded) {
+		return "", function.NewFuncError("decoded data is not valid UTF-8")
+	}
+	return string(decoded), nil
+}
+
+// Base64EncodeFunction encodes a string as base64 in a selectable variant
+var _ function.Function = &Base64EncodeFunction{}
+
+type Base64EncodeFunction struct{}
+
+func NewBase64EncodeFunction() function.Function {
+	return &Base64EncodeFunction{}
+}
+
+func (f *Base64EncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base64encode"
+}
+
+func (f *Base64EncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as base64",
+		Description: "Encodes the UTF-8 bytes of the string as base64 (RFC 4648). The variant selects the alphabet and padding: 'std' uses '+' and '/' with '=' padding, 'urlsafe' uses '-' and '_' with padding, and 'raw_std' and 'raw_urlsafe' are the same without padding.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "variant",
+			Description: "Optional variant: 'std', 'urlsafe', 'raw_std' or 'raw_urlsafe', defaults to 'std'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Base64EncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var variants []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &variants))
+	if resp.Error != nil {
+		return
+	}
+
+	variant, funcErr := optionalArgument(variants, 1, "std")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encoding, funcErr := lookupEncoding(base64Encodings, "variant", variant, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encoding.EncodeToString([]byte(input))))
+}
+
+// Base64DecodeFunction decodes a base64 string in a selectable variant
+var _ function.Function = &Base64DecodeFunction{}
+
+type Base64DecodeFunction struct{}
+
+func NewBase64DecodeFunction() function.Function {
+	return &Base64DecodeFunction{}
+}
+
+func (f *Base64DecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base64decode"
+}
+
+func (f *Base64DecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a base64 string",
+		Description: "Decodes base64 (RFC 4648) in the given variant, the inverse of base64encode. Malformed input, including wrong padding for the variant, is an error rather than a partial result, and so is decoded data that is not valid UTF-8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The base64 string to decode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "variant",
+			Description: "Optional variant: 'std', 'urlsafe', 'raw_std' or 'raw_urlsafe', defaults to 'std'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Base64DecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var variants []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &variants))
+	if resp.Error != nil {
+		return
+	}
+
+	variant, funcErr := optionalArgument(variants, 1, "std")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encoding, funcErr := lookupEncoding(base64Encodings, "variant", variant, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	decoded, err := encoding.DecodeString(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid base64: %s", err))
+		return
+	}
+	result, funcErr := decodedString(decoded)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// base32Encodings maps the variant names accepted by the base32 functions to their encodings
+var base32Encodings = map[string]*base32.Encoding{
+	"std":     base32.StdEncoding,
+	"hex":     base32.HexEncoding,
+	"raw_std": base32.StdEncoding.WithPadding(base32.NoPadding),
+	"raw_hex": base32.HexEncoding.WithPadding(base32.NoPadding),
+}
+
+// Base32EncodeFunction encodes a string as base32 in a selectable variant
+var _ function.Function = &Base32EncodeFunction{}
+
+type Base32EncodeFunction struct{}
+
+func NewBase32EncodeFunction() function.Function {
+	return &Base32EncodeFunction{}
+}
+
+func (f *Base32EncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base32encode"
+}
+
+func (f *Base32EncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as base32",
+		Description: "Encodes the UTF-8 bytes of the string as base32 (RFC 4648). The variant selects the alphabet and padding: 'std' uses 'A' to 'Z' and '2' to '7' with '=' padding, 'hex' uses the extended hex alphabet '0' to '9' and 'A' to 'V' with padding, and 'raw_std' and 'raw_hex' are the same without padding.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "variant",
+			Description: "Optional variant: 'std', 'hex', 'raw_std' or 'raw_hex', defaults to 'std'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Base32EncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var variants []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &variants))
+	if resp.Error != nil {
+		return
+	}
+
+	variant, funcErr := optionalArgument(variants, 1, "std")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encoding, funcErr := lookupEncoding(base32Encodings, "variant", variant, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encoding.EncodeToString([]byte(input))))
+}
+
+// Base32DecodeFunction decodes a base32 string in a selectable variant
+var _ function.Function = &Base32DecodeFunction{}
+
+type Base32DecodeFunction struct{}
+
+func NewBase32DecodeFunction() function.Function {
+	return &Base32DecodeFunction{}
+}
+
+func (f *Base32DecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "base32decode"
+}
+
+func (f *Base32DecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a base32 string",
+		Description: "Decodes base32 (RFC 4648) in the given variant, the inverse of base32encode. Characters outside the variant's alphabet, including lowercase letters, and wrong padding are an error, and so is decoded data that is not valid UTF-8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The base32 string to decode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "variant",
+			Description: "Optional variant: 'std', 'hex', 'raw_std' or 'raw_hex', defaults to 'std'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Base32DecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var variants []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &variants))
+	if resp.Error != nil {
+		return
+	}
+
+	variant, funcErr := optionalArgument(variants, 1, "std")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encoding, funcErr := lookupEncoding(base32Encodings, "variant", variant, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	decoded, err := encoding.DecodeString(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid base32: %s", err))
+		return
+	}
+	result, funcErr := decodedString(decoded)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// HexEncodeFunction encodes a string as hexadecimal
+var _ function.Function = &HexEncodeFunction{}
+
+type HexEncodeFunction struct{}
+
+func NewHexEncodeFunction() function.Function {
+	return &HexEncodeFunction{}
+}
+
+func (f *HexEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hex_encode"
+}
+
+func (f *HexEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as hexadecimal",
+		Description: "Encodes the UTF-8 bytes of the string as hexadecimal, two digits per byte, in lowercase unless uppercase is true. For example: 'Hi' becomes '4869'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "uppercase",
+			Description: "Optional flag to use uppercase hex digits, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HexEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var uppercases []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &uppercases))
+	if resp.Error != nil {
+		return
+	}
+
+	uppercase, funcErr := optionalArgument(uppercases, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := hex.EncodeToString([]byte(input))
+	if uppercase {
+		result = strings.ToUpper(result)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// HexDecodeFunction decodes a hexadecimal string
+var _ function.Function = &HexDecodeFunction{}
+
+type HexDecodeFunction struct{}
+
+func NewHexDecodeFunction() function.Function {
+	return &HexDecodeFunction{}
+}
+
+func (f *HexDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hex_decode"
+}
+
+func (f *HexDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a hexadecimal string",
+		Description: "Decodes hexadecimal in either case, the inverse of hex_encode. An odd number of digits or a character that is not a hex digit is an error, and so is decoded data that is not valid UTF-8. For example: '4869' becomes 'Hi'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The hexadecimal string to decode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HexDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid hex: %s", err))
+		return
+	}
+	result, funcErr := decodedString(decoded)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// percentEncode escapes every byte of s except the RFC 3986 unreserved characters
+// (letters, digits, '-', '.', '_' and '~') as %XX
+func percentEncode(s string) string {
+	var result strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < utf8.RuneSelf && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)) || strings.IndexByte("-._~", c) >= 0) {
+			result.WriteByte(c)
+		} else {
+			fmt.Fprintf(&result, "%%%02X", c)
+		}
+	}
+	return result.String()
+}
+
+// urlEncodeStyles maps the style names accepted by url_encode to their escaping functions
+var urlEncodeStyles = map[string]func(string) string{
+	"component": percentEncode,
+	"query":     url.QueryEscape,
+	"path":      url.PathEscape,
+}
+
+// urlDecodeStyles maps the style names accepted by url_decode to their unescaping functions
+var urlDecodeStyles = map[string]func(string) (string, error){
+	"component": url.PathUnescape,
+	"query":     url.QueryUnescape,
+	"path":      url.PathUnescape,
+}
+
+// UrlEncodeFunction percent-encodes a string for use in a URL
+var _ function.Function = &UrlEncodeFunction{}
+
+type UrlEncodeFunction struct{}
+
+func NewUrlEncodeFunction() function.Function {
+	return &UrlEncodeFunction{}
+}
+
+func (f *UrlEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_encode"
+}
+
+func (f *UrlEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Percent-encode a string for a URL",
+		Description: "Percent-encodes the UTF-8 bytes of the string. The default 'component' style escapes everything except letters, digits, '-', '.', '_' and '~', with a space as '%20'. The 'query' style writes a space as '+' instead, as in form data, and the 'path' style keeps characters that are allowed in a path segment, such as '&' and '='. For example: 'a b&c' becomes 'a%20b%26c'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "style",
+			Description: "Optional style: 'component', 'query' or 'path', defaults to 'component'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UrlEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var styles []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &styles))
+	if resp.Error != nil {
+		return
+	}
+
+	style, funcErr := optionalArgument(styles, 1, "component")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encode, funcErr := lookupEncoding(urlEncodeStyles, "style", style, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encode(input)))
+}
+
+// UrlDecodeFunction decodes a percent-encoded string
+var _ function.Function = &UrlDecodeFunction{}
+
+type UrlDecodeFunction struct{}
+
+func NewUrlDecodeFunction() function.Function {
+	return &UrlDecodeFunction{}
+}
+
+func (f *UrlDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_decode"
+}
+
+func (f *UrlDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a percent-encoded string",
+		Description: "Decodes '%XX' escapes, the inverse of url_encode. In the 'query' style a '+' also decodes to a space, while the default 'component' and the 'path' styles leave '+' as it is. A '%' that is not followed by two hex digits is an error, and so is decoded data that is not valid UTF-8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to decode",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "style",
+			Description: "Optional style: 'component', 'query' or 'path', defaults to 'component'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UrlDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var styles []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &styles))
+	if resp.Error != nil {
+		return
+	}
+
+	style, funcErr := optionalArgument(styles, 1, "component")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	decode, funcErr := lookupEncoding(urlDecodeStyles, "style", style, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	decoded, err := decode(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid percent-encoding: %s", err))
+		return
+	}
+	result, funcErr := decodedString([]byte(decoded))
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// HtmlEscapeFunction escapes special characters for use in HTML
+var _ function.Function = &HtmlEscapeFunction{}
+
+type HtmlEscapeFunction struct{}
+
+func NewHtmlEscapeFunction() function.Function {
+	return &HtmlEscapeFunction{}
+}
+
+func (f *HtmlEscapeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "html_escape"
+}
+
+func (f *HtmlEscapeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Escape a string for HTML",
+		Description: "Escapes the five characters that are special in HTML: '<' becomes '&lt;', '>' becomes '&gt;', '&' becomes '&amp;', a single quote becomes '&#39;' and a double quote becomes '&#34;'. The result is safe in element content and in quoted attribute values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to escape",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HtmlEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, html.EscapeString(input)))
+}
+
+// HtmlUnescapeFunction decodes HTML entities and character references
+var _ function.Function = &HtmlUnescapeFunction{}
+
+type HtmlUnescapeFunction struct{}
+
+func NewHtmlUnescapeFunction() function.Function {
+	return &HtmlUnescapeFunction{}
+}
+
+func (f *HtmlUnescapeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "html_unescape"
+}
+
+func (f *HtmlUnescapeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Unescape HTML entities",
+		Description: "Decodes HTML named entities such as '&lt;' and '&eacute;' and numeric character references such as '&#34;' and '&#x27;', the inverse of html_escape. Text that is not a recognized entity is left as it is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to unescape",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HtmlUnescapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, html.UnescapeString(input)))
+}
+
+// isXMLChar reports whether r is allowed in an XML 1.0 document
+func isXMLChar(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		(r >= 0x20 && r <= 0xD7FF) ||
+		(r >= 0xE000 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= unicode.MaxRune)
+}
+
+// XmlEscapeFunction escapes special characters for use in XML
+var _ function.Function = &XmlEscapeFunction{}
+
+type XmlEscapeFunction struct{}
+
+func NewXmlEscapeFunction() function.Function {
+	return &XmlEscapeFunction{}
+}
+
+func (f *XmlEscapeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "xml_escape"
+}
+
+func (f *XmlEscapeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Escape a string for XML",
+		Description: "Escapes '<', '>', '&', a single quote ('&#39;') and a double quote ('&#34;'), and writes tab, newline and carriage return as character references so they survive in attribute values. Control characters that XML 1.0 does not allow at all, not even as references, are replaced with U+FFFD, or removed when strip_invalid is true.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to escape",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "strip_invalid",
+			Description: "Optional flag to remove characters that are not allowed in XML 1.0 instead of replacing them, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *XmlEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var stripInvalids []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &stripInvalids))
+	if resp.Error != nil {
+		return
+	}
+
+	stripInvalid, funcErr := optionalArgument(stripInvalids, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if stripInvalid {
+		input = strings.Map(func(r rune) rune {
+			if isXMLChar(r) {
+				return r
+			}
+			return -1
+		}, input)
+	}
+
+	var result strings.Builder
+	if err := xml.EscapeText(&result, []byte(input)); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// jsonEscape escapes s as the body of a JSON string: quotes, backslashes and control
+// characters are always escaped, and every non-ASCII character is too when ascii is true
+func jsonEscape(s string, ascii bool) string {
+	var result strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '"':
+			result.WriteString(`\"`)
+		case r == '\\':
+			result.WriteString(`\\`)
+		case r == '\n':
+			result.WriteString(`\n`)
+		case r == '\r':
+			result.WriteString(`\r`)
+		case r == '\t':
+			result.WriteString(`\t`)
+		case r == '\b':
+			result.WriteString(`\b`)
+		case r == '\f':
+			result.WriteString(`\f`)
+		case r < 0x20 || (ascii && r > unicode.MaxASCII):
+			if r1, r2 := utf16.EncodeRune(r); r1 != unicode.ReplacementChar {
+				fmt.Fprintf(&result, `\u%04x\u%04x`, r1, r2)
+			} else {
+				fmt.Fprintf(&result, `\u%04x`, r)
+			}
+		default:
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+// JsonEscapeFunction escapes a string for use inside a JSON string
+var _ function.Function = &JsonEscapeFunction{}
+
+type JsonEscapeFunction struct{}
+
+func NewJsonEscapeFunction() function.Function {
+	return &JsonEscapeFunction{}
+}
+
+func (f *JsonEscapeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_escape"
+}
+
+func (f *JsonEscapeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Escape a string for JSON",
+		Description: "Escapes the string as the contents of a JSON string: '\"' and '\\' are backslash-escaped, line feeds, carriage returns, tabs, backspaces and form feeds use their short escapes, and other control characters become '\\u00XX'. The surrounding quotes are added only when quote is true, and non-ASCII characters are written as '\\uXXXX' escapes (surrogate pairs outside the Basic Multilingual Plane) when ascii is true.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to escape",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "Optional object with the bool attributes quote, to wrap the result in double quotes, and ascii, to escape every non-ASCII character, each defaulting to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JsonEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionValues []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &optionValues))
+	if resp.Error != nil {
+		return
+	}
+
+	options, funcErr := optionsArgument(optionValues, 1, "quote", "ascii")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	quote, funcErr := boolOption(options, "quote", 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	ascii, funcErr := boolOption(options, "ascii", 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := jsonEscape(input, ascii)
+	if quote {
+		result = `"` + result + `"`
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// rotate shifts the ASCII letters in s by shift places through the alphabet, keeping their
+// case, and the digits by shift places through 0-9 when rotateDigits is true. Any shift,
+// including a negative one, is reduced modulo the size of the alphabet.
+func rotate(s string, shift int64, rotateDigits bool) string {
+	rotateIn := func(r, first rune, size int64) rune {
+		return first + rune((int64(r-first)+shift%size+size)%size)
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return rotateIn(r, 'a', 26)
+		case r >= 'A' && r <= 'Z':
+			return rotateIn(r, 'A', 26)
+		case rotateDigits && r >= '0' && r <= '9':
+			return rotateIn(r, '0', 10)
+		default:
+			return r
+		}
+	}, s)
+}
+
+// RotNFunction shifts letters through the alphabet by a configurable amount
+var _ function.Function = &RotNFunction{}
+
+type RotNFunction struct{}
+
+func NewRotNFunction() function.Function {
+	return &RotNFunction{}
+}
+
+func (f *RotNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rot_n"
+}
+
+func (f *RotNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Rotate letters by N places",
+		Description: "Shifts each ASCII letter n places through the alphabet, wrapping from 'z' to 'a' and keeping its case. Other characters are unchanged. A negative n shifts backwards and any n is reduced modulo 26, so a shift of 13 is rot13. For example: 'abc' with a shift of 3 becomes 'def'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to rotate",
+			},
+			function.Int64Parameter{
+				Name:        "n",
+				Description: "The number of places to shift each letter",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RotNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var n int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &n))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rotate(input, n, false)))
+}
+
+// CaesarFunction applies a Caesar cipher to letters and optionally digits
+var _ function.Function = &CaesarFunction{}
+
+type CaesarFunction struct{}
+
+func NewCaesarFunction() function.Function {
+	return &CaesarFunction{}
+}
+
+func (f *CaesarFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "caesar"
+}
+
+func (f *CaesarFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Apply a Caesar cipher",
+		Description: "Shifts each ASCII letter shift places through the alphabet like rot_n, wrapping around and keeping its case. When rotate_digits is true, the digits 0-9 are also shifted by the same amount, wrapping from '9' to '0'. For example: 'abc123' with a shift of 1 becomes 'bcd123', or 'bcd234' with digits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encipher",
+			},
+			function.Int64Parameter{
+				Name:        "shift",
+				Description: "The number of places to shift each letter, and each digit if enabled",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "rotate_digits",
+			Description: "Optional flag to also rotate the digits 0-9, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CaesarFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var shift int64
+	var rotateDigitsValues []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &shift, &rotateDigitsValues))
+	if resp.Error != nil {
+		return
+	}
+
+	rotateDigits, funcErr := optionalArgument(rotateDigitsValues, 2, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rotate(input, shift, rotateDigits)))
+}
+
+// Md5Function returns the MD5 digest of a string
+var _ function.Function = &Md5Function{}
+
+type Md5Function struct{}
+
+func NewMd5Function() function.Function {
+	return &Md5Function{}
+}
+
+func (f *Md5Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "md5"
+}
+
+func (f *Md5Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the MD5 digest",
+		Description: "Returns the MD5 digest of the UTF-8 bytes of the string as 32 lowercase hex digits. MD5 is not collision resistant, so use it for cache keys and checksums, not for security. For example: 'hello' becomes '5d41402abc4b2a76b9719d911017c592'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to hash",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Md5Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	sum := md5.Sum([]byte(input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToString(sum[:])))
+}
+
+// Sha1Function returns the SHA-1 digest of a string
+var _ function.Function = &Sha1Function{}
+
+type Sha1Function struct{}
+
+func NewSha1Function() function.Function {
+	return &Sha1Function{}
+}
+
+func (f *Sha1Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sha1"
+}
+
+func (f *Sha1Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the SHA-1 digest",
+		Description: "Returns the SHA-1 digest of the UTF-8 bytes of the string as 40 lowercase hex digits. SHA-1 is not collision resistant, so use it for legacy integrations and checksums, not for security. For example: 'hello' becomes 'aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to hash",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Sha1Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	sum := sha1.Sum([]byte(input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToString(sum[:])))
+}
+
+// digestEncodings maps the encoding names accepted by the hash functions to their encoders
+var digestEncodings = map[string]func([]byte) string{
+	"hex":       hex.EncodeToString,
+	"base64":    base64.StdEncoding.EncodeToString,
+	"base64url": base64.URLEncoding.EncodeToString,
+}
+
+// Sha256Function returns the SHA-256 digest of a string
+var _ function.Function = &Sha256Function{}
+
+type Sha256Function struct{}
+
+func NewSha256Function() function.Function {
+	return &Sha256Function{}
+}
+
+func (f *Sha256Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sha256"
+}
+
+func (f *Sha256Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the SHA-256 digest",
+		Description: "Returns the SHA-256 digest of the UTF-8 bytes of the string. The encoding selects the output format: 'hex' gives 64 lowercase hex digits, 'base64' gives padded standard base64 as used by Subresource Integrity, and 'base64url' gives padded URL-safe base64.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to hash",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "encoding",
+			Description: "Optional output encoding: 'hex', 'base64' or 'base64url', defaults to 'hex'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Sha256Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var encodings []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &encodings))
+	if resp.Error != nil {
+		return
+	}
+
+	encodingName, funcErr := optionalArgument(encodings, 1, "hex")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encode, funcErr := lookupEncoding(digestEncodings, "encoding", encodingName, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	sum := sha256.Sum256([]byte(input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encode(sum[:])))
+}
+
+// Sha512Function returns the SHA-512 digest of a string
+var _ function.Function = &Sha512Function{}
+
+type Sha512Function struct{}
+
+func NewSha512Function() function.Function {
+	return &Sha512Function{}
+}
+
+func (f *Sha512Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sha512"
+}
+
+func (f *Sha512Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the SHA-512 digest",
+		Description: "Returns the SHA-512 digest of the UTF-8 bytes of the string. The encoding selects the output format: 'hex' gives 128 lowercase hex digits, 'base64' gives padded standard base64 as used by Subresource Integrity, and 'base64url' gives padded URL-safe base64.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to hash",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "encoding",
+			Description: "Optional output encoding: 'hex', 'base64' or 'base64url', defaults to 'hex'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Sha512Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var encodings []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &encodings))
+	if resp.Error != nil {
+		return
+	}
+
+	encodingName, funcErr := optionalArgument(encodings, 1, "hex")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	encode, funcErr := lookupEncoding(digestEncodings, "encoding", encodingName, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	sum := sha512.Sum512([]byte(input))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encode(sum[:])))
+}
+
+// crc32Formats maps the format names accepted by crc32 to their formatting functions
+var crc32Formats = map[string]func(uint32) string{
+	"hex": func(sum uint32) string {
+		return fmt.Sprintf("%08x", sum)
+	},
+	"decimal": func(sum uint32) string {
+		return strconv.FormatUint(uint64(sum), 10)
+	},
+}
+
+// Crc32Function returns the CRC-32 checksum of a string
+var _ function.Function = &Crc32Function{}
+
+type Crc32Function struct{}
+
+func NewCrc32Function() function.Function {
+	return &Crc32Function{}
+}
+
+func (f *Crc32Function) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crc32"
+}
+
+func (f *Crc32Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the CRC-32 checksum",
+		Description: "Returns the IEEE CRC-32 checksum of the UTF-8 bytes of the string, a fast non-cryptographic fingerprint. The format 'hex' gives 8 lowercase hex digits and 'decimal' gives the checksum as an unsigned decimal number in a string. For example: 'hello' becomes '3610a686', or '907060870' in decimal.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to checksum",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "format",
+			Description: "Optional output format: 'hex' or 'decimal', defaults to 'hex'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Crc32Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var formats []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &formats))
+	if resp.Error != nil {
+		return
+	}
+
+	formatName, funcErr := optionalArgument(formats, 1, "hex")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	format, funcErr := lookupEncoding(crc32Formats, "format", formatName, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, format(crc32.ChecksumIEEE([]byte(input)))))
+}
+
+// acePrefix marks a DNS label as the ASCII-compatible encoding of an internationalized label
+const acePrefix = "xn--"
+
+// PunycodeEncodeFunction encodes a single domain label as Punycode
+var _ function.Function = &PunycodeEncodeFunction{}
+
+type PunycodeEncodeFunction struct{}
+
+func NewPunycodeEncodeFunction() function.Function {
+	return &PunycodeEncodeFunction{}
+}
+
+func (f *PunycodeEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "punycode_encode"
+}
+
+func (f *PunycodeEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a domain label as Punycode",
+		Description: "Encodes a single internationalized domain label with Punycode (RFC 3492). The result is the bare Punycode without the 'xn--' prefix unless add_prefix is true, in which case it is the full ACE label used in DNS. The label is not lowercased or otherwise mapped. A pure-ASCII label needs no encoding: with add_prefix it is returned unchanged, as it would appear in DNS, and otherwise it is followed by the Punycode delimiter '-', so that 'example' becomes 'example-' and decodes back to 'example'. For example: 'münchen' becomes 'mnchen-3ya'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "label",
+				Description: "The domain label to encode, without dots",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "add_prefix",
+			Description: "Optional flag to prepend 'xn--' to encoded labels, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PunycodeEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var label string
+	var addPrefixes []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &label, &addPrefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	addPrefix, funcErr := optionalArgument(addPrefixes, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if strings.Contains(label, ".") {
+		resp.Error = function.NewArgumentFuncError(0, "label must be a single domain label without dots")
+		return
+	}
+
+	result, err := idna.Punycode.ToASCII(label)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if !addPrefix {
+		if encoded, ok := strings.CutPrefix(result, acePrefix); ok {
+			result = encoded
+		} else if label != "" {
+			// Bare Punycode of a pure-ASCII label ends in the delimiter, so that it decodes back to the label
+			result = label + "-"
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// PunycodeDecodeFunction decodes a Punycode domain label
+var _ function.Function = &PunycodeDecodeFunction{}
+
+type PunycodeDecodeFunction struct{}
+
+func NewPunycodeDecodeFunction() function.Function {
+	return &PunycodeDecodeFunction{}
+}
+
+func (f *PunycodeDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "punycode_decode"
+}
+
+func (f *PunycodeDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a Punycode domain label",
+		Description: "Decodes a single domain label from Punycode (RFC 3492), the inverse of punycode_encode. By default the label is bare Punycode such as 'mnchen-3ya', and a label that is plain ASCII must end in the delimiter '-', as in 'example-', because anything after the last '-' is read as encoded characters. When ace is true it is a full ACE label as used in DNS: a label starting with 'xn--' is decoded and any other label is returned unchanged. Malformed Punycode, and Punycode that decodes to control characters, is an error. For example: 'mnchen-3ya' becomes 'münchen'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "label",
+				Description: "The domain label to decode, without dots",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "ace",
+			Description: "Optional flag to treat the label as a full ACE label that is only decoded if it starts with 'xn--', defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PunycodeDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var label string
+	var aces []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &label, &aces))
+	if resp.Error != nil {
+		return
+	}
+
+	ace, funcErr := optionalArgument(aces, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if strings.Contains(label, ".") {
+		resp.Error = function.NewArgumentFuncError(0, "label must be a single domain label without dots")
+		return
+	}
+	if ace && !strings.HasPrefix(strings.ToLower(label), acePrefix) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, label))
+		return
+	}
+	if ace {
+		label = label[len(acePrefix):]
+	}
+
+	result, err := idna.Punycode.ToUnicode(acePrefix + label)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid Punycode: %s", err))
+		return
+	}
+	if strings.ContainsFunc(result, unicode.IsControl) {
+		resp.Error = function.NewArgumentFuncError(0, "invalid Punycode: decoded label contains control characters")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// Ascii85EncodeFunction encodes a string as Ascii85
+var _ function.Function = &Ascii85EncodeFunction{}
+
+type Ascii85EncodeFunction struct{}
+
+func NewAscii85EncodeFunction() function.Function {
+	return &Ascii85EncodeFunction{}
+}
+
+func (f *Ascii85EncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ascii85_encode"
+}
+
+func (f *Ascii85EncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as Ascii85",
+		Description: "Encodes the UTF-8 bytes of the string as Ascii85 in the btoa variant, with 'z' abbreviating a group of four zero bytes and no line breaks. The Adobe variant's '<~' and '~>' delimiters are added only when adobe is true. For example: 'hello' becomes 'BOu!rDZ'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "adobe",
+			Description: "Optional flag to wrap the result in '<~' and '~>', defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Ascii85EncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var adobes []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &adobes))
+	if resp.Error != nil {
+		return
+	}
+
+	adobe, funcErr := optionalArgument(adobes, 1, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	encoded := make([]byte, ascii85.MaxEncodedLen(len(input)))
+	result := string(encoded[:ascii85.Encode(encoded, []byte(input))])
+	if adobe {
+		result = "<~" + result + "~>"
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// Ascii85DecodeFunction decodes an Ascii85 string
+var _ function.Function = &Ascii85DecodeFunction{}
+
+type Ascii85DecodeFunction struct{}
+
+func NewAscii85DecodeFunction() function.Function {
+	return &Ascii85DecodeFunction{}
+}
+
+func (f *Ascii85DecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ascii85_decode"
+}
+
+func (f *Ascii85DecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode an Ascii85 string",
+		Description: "Decodes Ascii85, the inverse of ascii85_encode. Both the bare btoa form and the Adobe form wrapped in '<~' and '~>' are accepted, and whitespace such as line breaks is ignored. Characters outside the Ascii85 alphabet are an error, and so is decoded data that is not valid UTF-8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The Ascii85 string to decode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Ascii85DecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	trimmed := strings.TrimSpace(input)
+	if strings.HasPrefix(trimmed, "<~") && strings.HasSuffix(trimmed, "~>") && len(trimmed) >= 4 {
+		trimmed = trimmed[2 : len(trimmed)-2]
+	}
+
+	decoded, err := io.ReadAll(ascii85.NewDecoder(strings.NewReader(trimmed)))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid Ascii85: %s", err))
+		return
+	}
+	result, funcErr := decodedString(decoded)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// QuotedPrintableFunction encodes a string as MIME quoted-printable
+var _ function.Function = &QuotedPrintableFunction{}
+
+type QuotedPrintableFunction struct{}
+
+func NewQuotedPrintableFunction() function.Function {
+	return &QuotedPrintableFunction{}
+}
+
+func (f *QuotedPrintableFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quoted_printable"
+}
+
+func (f *QuotedPrintableFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as quoted-printable",
+		Description: "Encodes the UTF-8 bytes of the string with MIME quoted-printable (RFC 2045): bytes outside printable ASCII and '=' become '=XX', and lines are wrapped at 76 characters with soft line breaks ('=' at the end of a line). Line breaks in the input are written as CRLF, as MIME requires. For example: 'héllo' becomes 'h=C3=A9llo'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuotedPrintableFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	var result strings.Builder
+	writer := quotedprintable.NewWriter(&result)
+	if _, err := writer.Write([]byte(input)); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	if err := writer.Close(); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// QuotedPrintableDecodeFunction decodes a MIME quoted-printable string
+var _ function.Function = &QuotedPrintableDecodeFunction{}
+
+type QuotedPrintableDecodeFunction struct{}
+
+func NewQuotedPrintableDecodeFunction() function.Function {
+	return &QuotedPrintableDecodeFunction{}
+}
+
+func (f *QuotedPrintableDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quoted_printable_decode"
+}
+
+func (f *QuotedPrintableDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a quoted-printable string",
+		Description: "Decodes MIME quoted-printable (RFC 2045), the inverse of quoted_printable: '=XX' escapes in either case become bytes and soft line breaks are removed. Like most mail readers it is lenient, so an '=' that does not start a valid escape is kept as it is. Decoded data that is not valid UTF-8 is an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The quoted-printable string to decode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuotedPrintableDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(input)))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid quoted-printable: %s", err))
+		return
+	}
+	result, funcErr := decodedString(decoded)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// morseCode maps letters, digits and punctuation to International Morse code
+var morseCode = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.", 'G': "--.",
+	'H': "....", 'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..", 'M': "--", 'N': "-.",
+	'O': "---", 'P': ".--.", 'Q': "--.-", 'R': ".-.", 'S': "...", 'T': "-", 'U': "..-",
+	'V': "...-", 'W': ".--", 'X': "-..-", 'Y': "-.--", 'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+	'.': ".-.-.-", ',': "--..--", '?': "..--..", '\'': ".----.", '!': "-.-.--",
+	'/': "-..-.", '(': "-.--.", ')': "-.--.-", '&': ".-...", ':': "---...",
+	';': "-.-.-.", '=': "-...-", '+': ".-.-.", '-': "-....-", '_': "..--.-",
+	'"': ".-..-.", '$': "...-..-", '@': ".--.-.",
+}
+
+// MorseEncodeFunction encodes a string as International Morse code
+var _ function.Function = &MorseEncodeFunction{}
+
+type MorseEncodeFunction struct{}
+
+func NewMorseEncodeFunction() function.Function {
+	return &MorseEncodeFunction{}
+}
+
+func (f *MorseEncodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "morse_encode"
+}
+
+func (f *MorseEncodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a string as Morse code",
+		Description: "Encodes letters, digits and common punctuation as International Morse code, with a space between letters and ' / ' between words. Letters are latinized first, so accented letters are written as their base letter, and case is ignored. Any other character is an error rather than being dropped silently. For example: 'SOS' becomes '... --- ...'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MorseEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	var words []string
+	for _, word := range strings.Fields(strings.ToUpper(latinized)) {
+		var letters []string
+		for _, r := range word {
+			code, ok := morseCode[r]
+			if !ok {
+				resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("character %q has no Morse code", r))
+				return
+			}
+			letters = append(letters, code)
+		}
+		words = append(words, strings.Join(letters, " "))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(words, " / ")))
+}
+
+// MorseDecodeFunction decodes International Morse code
+var _ function.Function = &MorseDecodeFunction{}
+
+type MorseDecodeFunction struct{}
+
+func NewMorseDecodeFunction() function.Function {
+	return &MorseDecodeFunction{}
+}
+
+func (f *MorseDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "morse_decode"
+}
+
+func (f *MorseDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode Morse code",
+		Description: "Decodes International Morse code written as by morse_encode: letters are separated by spaces and words by '/'. The result is in uppercase, with a single space between words. A token that is not a known Morse code is an error. For example: '... --- ...' becomes 'SOS'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The Morse code to decode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MorseDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	letters := make(map[string]rune, len(morseCode))
+	for letter, code := range morseCode {
+		letters[code] = letter
+	}
+
+	var words []string
+	for _, word := range strings.Split(input, "/") {
+		var result strings.Builder
+		for _, token := range strings.Fields(word) {
+			letter, ok := letters[token]
+			if !ok {
+				resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid Morse code %q", token))
+				return
+			}
+			result.WriteRune(letter)
+		}
+		if result.Len() > 0 {
+			words = append(words, result.String())
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(words, " ")))
+}
+
+// leetspeakLevels maps the levels accepted by leetspeak to their letter substitutions
+var leetspeakLevels = map[string]map[rune]string{
+	"basic": {
+		'a': "4", 'e': "3", 'g': "6", 'i': "1", 'o': "0", 's': "5", 't': "7",
+	},
+	"aggressive": {
+		'a': "4", 'b': "8", 'c': "(", 'd': "|)", 'e': "3", 'f': "|=", 'g': "6",
+		'h': "#", 'i': "1", 'j': "_|", 'k': "|<", 'l': "1", 'm': "|\\/|", 'n': "|\\|",
+		'o': "0", 'p': "|>", 'q': "0_", 'r': "|2", 's': "5", 't': "7", 'u': "|_|",
+		'v': "\\/", 'w': "\\/\\/", 'x': "><", 'y': "`/", 'z': "2",
+	},
+}
+
+// LeetspeakFunction replaces letters with look-alike digits and symbols
+var _ function.Function = &LeetspeakFunction{}
+
+type LeetspeakFunction struct{}
+
+func NewLeetspeakFunction() function.Function {
+	return &LeetspeakFunction{}
+}
+
+func (f *LeetspeakFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "leetspeak"
+}
+
+func (f *LeetspeakFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert to leetspeak",
+		Description: "Replaces letters with look-alike digits and symbols, in either case. The 'basic' level only substitutes a→4, e→3, g→6, i→1, o→0, s→5 and t→7, leaving other letters as they are. The 'aggressive' level substitutes every ASCII letter, using multi-character symbols such as |< for k. For example: 'elite' becomes '3l173'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "level",
+			Description: "Optional substitution level: 'basic' or 'aggressive', defaults to 'basic'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LeetspeakFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var levels []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &levels))
+	if resp.Error != nil {
+		return
+	}
+
+	level, funcErr := optionalArgument(levels, 1, "basic")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	substitutions, funcErr := lookupEncoding(leetspeakLevels, "level", level, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	var result strings.Builder
+	for _, r := range input {
+		if substitute, ok := substitutions[unicode.ToLower(r)]; ok {
+			result.WriteString(substitute)
+		} else {
+			result.WriteRune(r)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// InitialsFunction returns the uppercased first letter of each word
+var _ function.Function = &InitialsFunction{}
+
+type InitialsFunction struct{}
+
+func NewInitialsFunction() function.Function {
+	return &InitialsFunction{}
+}
+
+func (f *InitialsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "initials"
+}
+
+func (f *InitialsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the initials of a name",
+		Description: "Returns the first character of each word, uppercased and joined without separators. Latinizes first, so accented names give ASCII initials, then splits on non-alphanumeric characters. Optionally keeps only the first max_count initials. For example: 'John Ronald Reuel Tolkien' becomes 'JRRT', or 'JR' with a max_count of 2.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The name to take the initials of",
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:        "max_count",
+			Description: "Optional maximum number of initials, must be greater than zero",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *InitialsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var maxCounts []int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &maxCounts))
+	if resp.Error != nil {
+		return
+	}
+
+	maxCount, funcErr := optionalArgument(maxCounts, 1, math.MaxInt64)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if maxCount <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "max_count must be greater than zero")
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	var result strings.Builder
+	for i, word := range splitWords(latinized) {
+		if int64(i) >= maxCount {
+			break
+		}
+		result.WriteString(strings.ToUpper(word[:1]))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result.String()))
+}
+
+// ordinalSuffix returns the English ordinal suffix for n: "st", "nd", "rd" or "th"
+func ordinalSuffix(n int64) string {
+	if n < 0 {
+		n = -(n % 100)
+	}
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return "th"
+	case n%10 == 1:
+		return "st"
+	case n%10 == 2:
+		return "nd"
+	case n%10 == 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+// OrdinalFunction formats a number as an English ordinal
+var _ function.Function = &OrdinalFunction{}
+
+type OrdinalFunction struct{}
+
+func NewOrdinalFunction() function.Function {
+	return &OrdinalFunction{}
+}
+
+func (f *OrdinalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ordinal"
+}
+
+func (f *OrdinalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Format a number as an English ordinal",
+		Description: "Appends the English ordinal suffix to a whole number: 'st', 'nd' or 'rd' after a last digit of 1, 2 or 3, except for 11, 12 and 13, which like every other number take 'th'. A negative number takes the suffix of its absolute value. For example: 22 becomes '22nd', 111 becomes '111th' and -3 becomes '-3rd'.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "number",
+				Description: "The number to format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *OrdinalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var number int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &number))
+	if resp.Error != nil {
+		return
+	}
+
+	result := strconv.FormatInt(number, 10) + ordinalSuffix(number)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// irregularPlurals maps English nouns that do not follow the suffix rules to their plurals,
+// including the few words ending in a single vowel and "z" that double the "z", as in "quizzes"
+var irregularPlurals = map[string]string{
+	"person": "people",
+	"child":  "children",
+	"man":    "men",
+	"woman":  "women",
+	"mouse":  "mice",
+	"goose":  "geese",
+	"foot":   "feet",
+	"tooth":  "teeth",
+	"ox":     "oxen",
+	"leaf":   "leaves",
+	"life":   "lives",
+	"knife":  "knives",
+	"wife":   "wives",
+	"half":   "halves",
+	"wolf":   "wolves",
+	"shelf":  "shelves",
+	"quiz":   "quizzes",
+	"whiz":   "whizzes",
+	"fez":    "fezzes",
+}
+
+// uncountableNouns lists English nouns whose plural is the same as the singular
+var uncountableNouns = []string{"sheep", "fish", "deer", "series", "species", "news", "information", "equipment"}
+
+// splitLastWord splits s before the run of letters at its end
+func splitLastWord(s string) (string, string) {
+	start := 0
+	if i := strings.LastIndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) }); i >= 0 {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		start = i + size
+	}
+	return s[:start], s[start:]
+}
+
+// isUpperWord reports whether word is written in capitals, such as "BUS"
+func isUpperWord(word string) bool {
+	return utf8.RuneCountInString(word) > 1 && word == strings.ToUpper(word)
+}
+
+// matchWordCase spells replacement in the case of word: all capitals, capitalized or as is
+func matchWordCase(word, replacement string) string {
+	first, _ := utf8.DecodeRuneInString(word)
+	switch {
+	case isUpperWord(word):
+		return strings.ToUpper(replacement)
+	case unicode.IsUpper(first):
+		return titleWord(replacement, caseOptions{})
+	default:
+		return replacement
+	}
+}
+
+// endsInConsonantY reports whether lower ends in a 'y' after a consonant, as in "city"
+func endsInConsonantY(lower string) bool {
+	return len(lower) >= 2 && strings.HasSuffix(lower, "y") && !strings.ContainsRune("aeiouy", rune(lower[len(lower)-2]))
+}
+
+// toPlural returns the English plural of the last word of s
+func toPlural(s string) string {
+	prefix, word := splitLastWord(s)
+	lower := strings.ToLower(word)
+	if word == "" || slices.Contains(uncountableNouns, lower) {
+		return s
+	}
+	if plural, ok := irregularPlurals[lower]; ok {
+		return prefix + matchWordCase(word, plural)
+	}
+
+	suffix := "s"
+	switch {
+	case endsInConsonantY(lower):
+		word, suffix = word[:len(word)-1], "ies"
+	case strings.HasSuffix(lower, "s") || strings.HasSuffix(lower, "x") || strings.HasSuffix(lower, "z") ||
+		strings.HasSuffix(lower, "ch") || strings.HasSuffix(lower, "sh"):
+		suffix = "es"
+	}
+	if isUpperWord(word) {
+		suffix = strings.ToUpper(suffix)
+	}
+	return prefix + word + suffix
+}
+
+// PluralizeFunction returns the English plural of a noun
+var _ function.Function = &PluralizeFunction{}
+
+type PluralizeFunction struct{}
+
+func NewPluralizeFunction() function.Function {
+	return &PluralizeFunction{}
+}
+
+func (f *PluralizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pluralize"
+}
+
+func (f *PluralizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Pluralize an English noun",
+		Description: "Returns the English plural of the last word of the string, keeping its case. A 'y' after a consonant becomes 'ies', words ending in 's', 'x', 'z', 'ch' or 'sh' take 'es' and others take 's'. Common irregular nouns such as 'person' and 'child' use their irregular plural, and nouns such as 'sheep' are unchanged. When count is given and equal to 1 the noun is returned as it is. For example: 'city' becomes 'cities' and 'bus' becomes 'buses'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The noun to pluralize",
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:        "count",
+			Description: "Optional count of items, the noun is only pluralized if it is not 1",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PluralizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var counts []int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &counts))
+	if resp.Error != nil {
+		return
+	}
+
+	count, funcErr := optionalArgument(counts, 1, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result := input
+	if count != 1 {
+		result = toPlural(input)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// singularSuffixes lists plural endings that take 'es', as in "boxes", "waltzes" and
+// "aliases", so that only the 's' is dropped from other words ending in 'es', as in "houses"
+var singularSuffixes = []string{"sses", "shes", "ches", "xes", "zzes", "ases", "ises", "oses"}
+
+// eSingulars lists common nouns ending in 'e' whose plural only adds 's', but that the rules
+// of toSingular would otherwise cut short, such as "movies" and "databases"
+var eSingulars = []string{
+	"movie", "cookie", "rookie", "zombie", "calorie", "selfie", "hoodie", "brownie", "genie",
+	"pixie", "smoothie", "goalie", "sortie", "auntie", "newbie", "freebie", "pie", "tie", "lie",
+	"case", "base", "database", "codebase", "phase", "purchase", "release", "lease", "increase",
+	"decrease", "chase", "phrase", "showcase", "suitcase", "testcase", "promise", "premise",
+	"exercise", "enterprise", "noise", "franchise", "surprise", "expertise", "purpose", "rose",
+	"hose", "nose", "close", "dose", "pose", "prose",
+}
+
+// toSingular returns the English singular of the last word of s, reversing toPlural
+func toSingular(s string) string {
+	prefix, word := splitLastWord(s)
+	lower := strings.ToLower(word)
+	if word == "" || slices.Contains(uncountableNouns, lower) {
+		return s
+	}
+	for singular, plural := range irregularPlurals {
+		if lower == plural {
+			return prefix + matchWordCase(word, singular)
+		}
+	}
+
+	consonantBefore := func(suffix string) bool {
+		stem := strings.TrimSuffix(lower, suffix)
+		return len(stem) > 0 && !strings.ContainsRune("aeiou", rune(stem[len(stem)-1]))
+	}
+
+	var suffix string
+	switch {
+	case strings.HasSuffix(lower, "s") && slices.Contains(eSingulars, lower[:len(lower)-1]):
+		word = word[:len(word)-1]
+	case strings.HasSuffix(lower, "ies") && consonantBefore("ies"):
+		word, suffix = word[:len(word)-3], "y"
+	case slices.ContainsFunc(singularSuffixes, func(s string) bool { return strings.HasSuffix(lower, s) }),
+		strings.HasSuffix(lower, "uses") && consonantBefore("uses"),
+		strings.HasSuffix(lower, "zes") && consonantBefore("zes"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "us"), strings.HasSuffix(lower, "is"):
+	case strings.HasSuffix(lower, "s"):
+		word = word[:len(word)-1]
+	}
+	if isUpperWord(word) {
+		suffix = strings.ToUpper(suffix)
+	}
+	return prefix + word + suffix
+}
+
+// SingularizeFunction returns the English singular of a noun
+var _ function.Function = &SingularizeFunction{}
+
+type SingularizeFunction struct{}
+
+func NewSingularizeFunction() function.Function {
+	return &SingularizeFunction{}
+}
+
+func (f *SingularizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "singularize"
+}
+
+func (f *SingularizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Singularize an English noun",
+		Description: "Returns the English singular of the last word of the string, keeping its case, reversing the rules of pluralize. 'ies' after a consonant becomes 'y', 'es' is dropped after 'ss', 'sh', 'ch', 'x', 'zz' and a vowel followed by 's', as in 'aliases', and a final 's' is dropped otherwise. Common nouns ending in 'e', such as 'movie' and 'database', only lose the 's'. Common irregular nouns such as 'people' and 'children' use their irregular singular, and nouns such as 'sheep' are unchanged. For example: 'cities' becomes 'city' and 'buses' becomes 'bus'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The noun to singularize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SingularizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, toSingular(input)))
+}
+
+// romanNumerals lists the values of the Roman numerals, including the subtractive pairs, from largest to smallest
+var romanNumerals = []struct {
+	value   int64
+	numeral string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// toRoman writes n, which must be between 1 and 3999, as a Roman numeral
+func toRoman(n int64) string {
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.numeral)
+			n -= r.value
+		}
+	}
+	return b.String()
+}
+
+// fromRoman parses a Roman numeral in its canonical form, as written by toRoman
+func fromRoman(s string) (int64, bool) {
+	var n int64
+	rest := strings.ToUpper(s)
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(rest, r.numeral) {
+			n += r.value
+			rest = rest[len(r.numeral):]
+		}
+	}
+	// Parsing greedily accepts non-canonical forms such as "IIII", which toRoman would not write
+	if rest != "" || n == 0 || toRoman(n) != strings.ToUpper(s) {
+		return 0, false
+	}
+	return n, true
+}
+
+// RomanFunction writes a number as a Roman numeral
+var _ function.Function = &RomanFunction{}
+
+type RomanFunction struct{}
+
+func NewRomanFunction() function.Function {
+	return &RomanFunction{}
+}
+
+func (f *RomanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "roman"
+}
+
+func (f *RomanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Write a number as a Roman numeral",
+		Description: "Returns the number written as an uppercase Roman numeral, using subtractive notation such as 'IV' for 4 and 'CM' for 900. The number must be between 1 and 3999. For example: 2024 becomes 'MMXXIV'.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "number",
+				Description: "The number to convert, from 1 to 3999",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RomanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var number int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &number))
+	if resp.Error != nil {
+		return
+	}
+
+	if number < 1 || number > 3999 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("number %d is out of range, Roman numerals can only be written for 1 to 3999", number))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, toRoman(number)))
+}
+
+// RomanToIntFunction parses a Roman numeral into a number
+var _ function.Function = &RomanToIntFunction{}
+
+type RomanToIntFunction struct{}
+
+func NewRomanToIntFunction() function.Function {
+	return &RomanToIntFunction{}
+}
+
+func (f *RomanToIntFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "roman_to_int"
+}
+
+func (f *RomanToIntFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Roman numeral",
+		Description: "Returns the number written by a Roman numeral, the inverse of roman. Letters may be upper or lower case, but the numeral must be well-formed, so 'IIII' and 'IC' are rejected in favour of 'IV' and 'XCIX'. For example: 'MMXXIV' becomes 2024.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The Roman numeral to parse",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *RomanToIntFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	number, ok := fromRoman(input)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid Roman numeral %q", input))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, number))
+}
+
+// stripTags returns the text content of an HTML fragment, with entities decoded and the
+// contents of script and style elements dropped
+func stripTags(s string) string {
+	var b strings.Builder
+	skipping := 0
+	tokenizer := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		switch tokenizer.Next() {
+		case nethtml.ErrorToken:
+			// The tokenizer reads to the end of the input however malformed it is, so the only
+			// error is io.EOF
+			return b.String()
+		case nethtml.TextToken:
+			if skipping == 0 {
+				b.Write(tokenizer.Text())
+			}
+		case nethtml.StartTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "script" || string(name) == "style" {
+				skipping++
+			}
+		case nethtml.EndTagToken:
+			if name, _ := tokenizer.TagName(); (string(name) == "script" || string(name) == "style") && skipping > 0 {
+				skipping--
+			}
+		}
+	}
+}
+
+// StripTagsFunction removes HTML tags from a string, keeping the text
+var _ function.Function = &StripTagsFunction{}
+
+type StripTagsFunction struct{}
+
+func NewStripTagsFunction() function.Function {
+	return &StripTagsFunction{}
+}
+
+func (f *StripTagsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_tags"
+}
+
+func (f *StripTagsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove HTML tags",
+		Description: "Returns the text content of an HTML fragment, removing tags and comments and decoding entities such as '&amp;'. The contents of script and style elements are removed along with their tags. The input is tokenized as HTML, so malformed or unclosed tags are handled gracefully. For example: '<b>Hello</b> <i>world</i>' becomes 'Hello world'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The HTML to strip tags from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripTagsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, stripTags(input)))
+}
+
+// squeeze replaces each run of whitespace in s with a single space, and removes it entirely
+// at the ends if trim is set
+func squeeze(s string, trim bool) string {
+	var b strings.Builder
+	inSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			inSpace = true
+			continue
+		}
+		if inSpace && (b.Len() > 0 || !trim) {
+			b.WriteByte(' ')
+		}
+		inSpace = false
+		b.WriteRune(r)
+	}
+	if inSpace && !trim {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// SqueezeFunction collapses runs of whitespace to a single space
+var _ function.Function = &SqueezeFunction{}
+
+type SqueezeFunction struct{}
+
+func NewSqueezeFunction() function.Function {
+	return &SqueezeFunction{}
+}
+
+func (f *SqueezeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "squeeze"
+}
+
+func (f *SqueezeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Collapse runs of whitespace",
+		Description: "Replaces each run of Unicode whitespace, such as spaces, tabs, newlines and no-break spaces, with a single space. Leading and trailing whitespace is removed unless trim is false, in which case it is collapsed like the rest. For example: 'a   b\\t\\tc' becomes 'a b c'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to squeeze",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "trim",
+			Description: "Optional flag to remove leading and trailing whitespace, defaults to true",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SqueezeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var trims []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &trims))
+	if resp.Error != nil {
+		return
+	}
+
+	trim, funcErr := optionalArgument(trims, 1, true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, squeeze(input, trim)))
+}
+
+// inCutset returns a predicate matching the characters of cutset, or whitespace if it is empty
+func inCutset(cutset string) func(rune) bool {
+	if cutset == "" {
+		return unicode.IsSpace
+	}
+	return func(r rune) bool {
+		return strings.ContainsRune(cutset, r)
+	}
+}
+
+// TrimFunction removes characters in a cutset from both ends of a string
+var _ function.Function = &TrimFunction{}
+
+type TrimFunction struct{}
+
+func NewTrimFunction() function.Function {
+	return &TrimFunction{}
+}
+
+func (f *TrimFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim"
+}
+
+func (f *TrimFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Trim characters from both ends",
+		Description: "Removes all characters that appear in the cutset from both ends of the string. An empty cutset trims Unicode whitespace instead. For example: 'xxhelloxx' with cutset 'x' becomes 'hello'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to trim",
+			},
+			function.StringParameter{
+				Name:        "cutset",
+				Description: "The characters to trim, or an empty string for whitespace",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TrimFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, cutset string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &cutset))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.TrimFunc(input, inCutset(cutset))))
+}
+
+// TrimLeftFunction removes characters in a cutset from the start of a string
+var _ function.Function = &TrimLeftFunction{}
+
+type TrimLeftFunction struct{}
+
+func NewTrimLeftFunction() function.Function {
+	return &TrimLeftFunction{}
+}
+
+func (f *TrimLeftFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_left"
+}
+
+func (f *TrimLeftFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Trim characters from the start",
+		Description: "Removes all characters that appear in the cutset from the start of the string. An empty cutset trims Unicode whitespace instead. For example: 'xxhelloxx' with cutset 'x' becomes 'helloxx'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to trim",
+			},
+			function.StringParameter{
+				Name:        "cutset",
+				Description: "The characters to trim, or an empty string for whitespace",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TrimLeftFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, cutset string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &cutset))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.TrimLeftFunc(input, inCutset(cutset))))
+}
+
+// TrimRightFunction removes characters in a cutset from the end of a string
+var _ function.Function = &TrimRightFunction{}
+
+type TrimRightFunction struct{}
+
+func NewTrimRightFunction() function.Function {
+	return &TrimRightFunction{}
+}
+
+func (f *TrimRightFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_right"
+}
+
+func (f *TrimRightFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Trim characters from the end",
+		Description: "Removes all characters that appear in the cutset from the end of the string. An empty cutset trims Unicode whitespace instead. For example: 'xxhelloxx' with cutset 'x' becomes 'xxhello'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to trim",
+			},
+			function.StringParameter{
+				Name:        "cutset",
+				Description: "The characters to trim, or an empty string for whitespace",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TrimRightFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, cutset string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &cutset))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.TrimRightFunc(input, inCutset(cutset))))
+}
+
+// TrimPrefixFunction removes the first matching prefix from a string
+var _ function.Function = &TrimPrefixFunction{}
+
+type TrimPrefixFunction struct{}
+
+func NewTrimPrefixFunction() function.Function {
+	return &TrimPrefixFunction{}
+}
+
+func (f *TrimPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_prefix"
+}
+
+func (f *TrimPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove the first matching prefix",
+		Description: "Removes a prefix from the string, trying each of the given prefixes in order and removing only the first one that matches. The string is returned unchanged if none match. For example: 'https://example.com' with prefixes 'https://' and 'http://' becomes 'example.com'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to trim",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "prefixes",
+			Description: "The prefixes to try, in order",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TrimPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var prefixes []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &prefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	result := input
+	for _, prefix := range prefixes {
+		if trimmed, ok := strings.CutPrefix(input, prefix); ok {
+			result = trimmed
+			break
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// TrimSuffixFunction removes the first matching suffix from a string
+var _ function.Function = &TrimSuffixFunction{}
+
+type TrimSuffixFunction struct{}
+
+func NewTrimSuffixFunction() function.Function {
+	return &TrimSuffixFunction{}
+}
+
+func (f *TrimSuffixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_suffix"
+}
+
+func (f *TrimSuffixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Remove the first matching suffix",
+		Description: "Removes a suffix from the string, trying each of the given suffixes in order and removing only the first one that matches. The string is returned unchanged if none match. For example: 'archive.tar.gz' with suffixes '.zip' and '.tar.gz' becomes 'archive'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to trim",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "suffixes",
+			Description: "The suffixes to try, in order",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TrimSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var suffixes []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &suffixes))
+	if resp.Error != nil {
+		return
+	}
+
+	result := input
+	for _, suffix := range suffixes {
+		if trimmed, ok := strings.CutSuffix(input, suffix); ok {
+			result = trimmed
+			break
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// EnsurePrefixFunction adds a prefix to a string unless it is already there
+var _ function.Function = &EnsurePrefixFunction{}
+
+type EnsurePrefixFunction struct{}
+
+func NewEnsurePrefixFunction() function.Function {
+	return &EnsurePrefixFunction{}
+}
+
+func (f *EnsurePrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ensure_prefix"
+}
+
+func (f *EnsurePrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Add a prefix if it is missing",
+		Description: "Returns the string with the prefix added to the start, unless the string already starts with it, so applying it twice has the same result as applying it once. For example: 'example.com' with prefix 'https://' becomes 'https://example.com'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to add the prefix to",
+			},
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The prefix the result must start with",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EnsurePrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, prefix string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &prefix))
+	if resp.Error != nil {
+		return
+	}
+
+	result := input
+	if !strings.HasPrefix(input, prefix) {
+		result = prefix + input
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// EnsureSuffixFunction adds a suffix to a string unless it is already there
+var _ function.Function = &EnsureSuffixFunction{}
+
+type EnsureSuffixFunction struct{}
+
+func NewEnsureSuffixFunction() function.Function {
+	return &EnsureSuffixFunction{}
+}
+
+func (f *EnsureSuffixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ensure_suffix"
+}
+
+func (f *EnsureSuffixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Add a suffix if it is missing",
+		Description: "Returns the string with the suffix added to the end, unless the string already ends with it, so applying it twice has the same result as applying it once. For example: '/foo' with suffix '/' becomes '/foo/', and '/foo/' stays '/foo/'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to add the suffix to",
+			},
+			function.StringParameter{
+				Name:        "suffix",
+				Description: "The suffix the result must end with",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EnsureSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, suffix string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &suffix))
+	if resp.Error != nil {
+		return
+	}
+
+	result := input
+	if !strings.HasSuffix(input, suffix) {
+		result = input + suffix
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// maskClusters replaces all but the first keepFirst and last keepLast grapheme clusters with
+// maskChar, masking every cluster if that would leave none masked
+func maskClusters(clusters []string, keepFirst, keepLast int, maskChar string) string {
+	if keepFirst+keepLast >= len(clusters) {
+		keepFirst, keepLast = 0, 0
+	}
+
+	var result strings.Builder
+	for i, cluster := range clusters {
+		if i < keepFirst || i >= len(clusters)-keepLast {
+			result.WriteString(cluster)
+		} else {
+			result.WriteString(maskChar)
+		}
+	}
+	return result.String()
+}
+
+// MaskFunction hides the middle of a string, keeping characters at either end visible
+var _ function.Function = &MaskFunction{}
+
+type MaskFunction struct{}
+
+func NewMaskFunction() function.Function {
+	return &MaskFunction{}
+}
+
+func (f *MaskFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mask"
+}
+
+func (f *MaskFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Mask a string, keeping the ends visible",
+		Description: "Replaces each character of the string with a mask character, except for the first keep_first and the last keep_last characters. Characters are grapheme clusters, so accented letters and emoji are masked as a whole. If keeping both ends would leave nothing masked, because the string is no longer than keep_first and keep_last together, the whole string is masked so that a short secret is never shown in full. The mask character defaults to '*'. For example: '4111111111111111' keeping 0 and 4 becomes '************1111'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to mask",
+			},
+			function.Int64Parameter{
+				Name:        "keep_first",
+				Description: "The number of characters to keep visible at the start",
+			},
+			function.Int64Parameter{
+				Name:        "keep_last",
+				Description: "The number of characters to keep visible at the end",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "mask_char",
+			Description: "Optional string to replace each masked character with, defaults to '*'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MaskFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var keepFirst, keepLast int64
+	var maskChars []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &keepFirst, &keepLast, &maskChars))
+	if resp.Error != nil {
+		return
+	}
+
+	maskChar, funcErr := optionalArgument(maskChars, 3, "*")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if keepFirst < 0 {
+		resp.Error = function.NewArgumentFuncError(1, "keep_first must not be negative")
+		return
+	}
+	if keepLast < 0 {
+		resp.Error = function.NewArgumentFuncError(2, "keep_last must not be negative")
+		return
+	}
+	if maskChar == "" {
+		resp.Error = function.NewArgumentFuncError(3, "mask_char must not be empty")
+		return
+	}
+
+	clusters, err := graphemeClusters(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	keepFirst = min(keepFirst, int64(len(clusters)))
+	keepLast = min(keepLast, int64(len(clusters)))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, maskClusters(clusters, int(keepFirst), int(keepLast), maskChar)))
+}
+
+// MaskEmailFunction hides the local part of an email address, keeping the domain visible
+var _ function.Function = &MaskEmailFunction{}
+
+type MaskEmailFunction struct{}
+
+func NewMaskEmailFunction() function.Function {
+	return &MaskEmailFunction{}
+}
+
+func (f *MaskEmailFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mask_email"
+}
+
+func (f *MaskEmailFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Mask the local part of an email address",
+		Description: "Masks the part of an email address before the last '@' with '*', keeping its first and last characters visible and the domain unchanged. A local part of two characters keeps only its first character, and a local part of one character is masked entirely. The address must have a non-empty local part and domain. For example: 'john.doe@example.com' becomes 'j******e@example.com'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The email address to mask",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MaskEmailFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	at := strings.LastIndex(input, "@")
+	if at <= 0 || at == len(input)-1 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid email address %q, expected local@domain", input))
+		return
+	}
+	local, domain := input[:at], input[at:]
+
+	clusters, err := graphemeClusters(local)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	keepLast := 1
+	if len(clusters) < 3 {
+		keepLast = 0
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, maskClusters(clusters, 1, keepLast, "*")+domain))
+}
+
+// RedactFunction replaces every match of a regular expression with a redaction token
+var _ function.Function = &RedactFunction{}
+
+type RedactFunction struct{}
+
+func NewRedactFunction() function.Function {
+	return &RedactFunction{}
+}
+
+func (f *RedactFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "redact"
+}
+
+func (f *RedactFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Redact matches of a regular expression",
+		Description: "Replaces every match of an RE2 regular expression in the string with a redaction token, which defaults to '[REDACTED]'. The token is inserted literally, so '$' in it does not refer to capture groups. Text that does not match is left unchanged. For example: 'token=ghp_abc123' with pattern 'ghp_[A-Za-z0-9]+' becomes 'token=[REDACTED]'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to redact",
+			},
+			function.StringParameter{
+				Name:        "pattern",
+				Description: "The RE2 regular expression matching the text to redact",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "replacement",
+			Description: "Optional token to replace each match with, defaults to '[REDACTED]'",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RedactFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, pattern string
+	var replacements []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &pattern, &replacements))
+	if resp.Error != nil {
+		return
+	}
+
+	replacement, funcErr := optionalArgument(replacements, 2, "[REDACTED]")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid pattern: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, re.ReplaceAllLiteralString(input, replacement)))
+}
+
+// wordWrap breaks each line of s at spaces so that no line is wider than width grapheme
+// clusters, except where a single word is wider on its own
+func wordWrap(s string, width int) (string, error) {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		var wrapped strings.Builder
+		lineWidth := 0
+		for _, word := range strings.Fields(line) {
+			clusters, err := graphemeClusters(word)
+			if err != nil {
+				return "", err
+			}
+			if lineWidth > 0 && lineWidth+1+len(clusters) > width {
+				wrapped.WriteByte('\n')
+				lineWidth = 0
+			} else if lineWidth > 0 {
+				wrapped.WriteByte(' ')
+				lineWidth++
+			}
+			wrapped.WriteString(word)
+			lineWidth += len(clusters)
+		}
+		lines[i] = wrapped.String()
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// WordWrapFunction wraps text at word boundaries to a maximum line width
+var _ function.Function = &WordWrapFunction{}
+
+type WordWrapFunction struct{}
+
+func NewWordWrapFunction() function.Function {
+	return &WordWrapFunction{}
+}
+
+func (f *WordWrapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "word_wrap"
+}
+
+func (f *WordWrapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Wrap text to a line width",
+		Description: "Inserts newlines between words so that no line is longer than width characters, counted as grapheme clusters. Words are never split, so a word longer than width is put on a line of its own. Existing newlines are kept, and runs of other whitespace between words become a single space. For example: 'the quick brown fox jumped' with width 10 becomes 'the quick\\nbrown fox\\njumped'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The text to wrap",
+			},
+			function.Int64Parameter{
+				Name:        "width",
+				Description: "The maximum number of characters per line",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WordWrapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var width int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &width))
+	if resp.Error != nil {
+		return
+	}
+
+	if width <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "width must be greater than zero")
+		return
+	}
+
+	result, err := wordWrap(input, int(min(width, math.MaxInt32)))
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// IndentFunction prefixes every line of a string
+var _ function.Function = &IndentFunction{}
+
+type IndentFunction struct{}
+
+func NewIndentFunction() function.Function {
+	return &IndentFunction{}
+}
+
+func (f *IndentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "indent"
+}
+
+func (f *IndentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Indent every line of a string",
+		Description: "Adds the prefix to the start of every line of the string. Blank lines, which are empty or only whitespace, are left as they are unless indent_blank is true, so that no trailing whitespace is added. A trailing newline ends the last line rather than starting a new one, so nothing is added after it. For example: 'a\\nb\\nc' with prefix '  ' becomes '  a\\n  b\\n  c'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to indent",
+			},
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The string to add to the start of each line",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "indent_blank",
+			Description: "Optional flag to indent blank lines too, defaults to false",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IndentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, prefix string
+	var indentBlanks []bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input, &prefix, &indentBlanks))
+	if resp.Error != nil {
+		return
+	}
+
+	indentBlank, funcErr := optionalArgument(indentBlanks, 2, false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	lines := strings.Split(input, "\n")
+	for i, line := range lines {
+		if i == len(lines)-1 && line == "" && i > 0 {
+			break
+		}
+		if indentBlank || strings.TrimSpace(line) != "" {
+			lines[i] = prefix + line
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(lines, "\n")))
+}
+
+// levenshtein returns the number of insertions, deletions and substitutions of grapheme
+// clusters needed to turn a into b
+func levenshtein(a, b []string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := range a {
+		current[0] = i + 1
+		for j := range b {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// SimilarityFunction scores how alike two strings are, from 0 to 1
+var _ function.Function = &SimilarityFunction{}
+
+type SimilarityFunction struct{}
+
+func NewSimilarityFunction() function.Function {
+	return &SimilarityFunction{}
+}
+
+func (f *SimilarityFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "similarity"
+}
+
+func (f *SimilarityFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Score the similarity of two strings",
+		Description: "Returns a number from 0 to 1 saying how alike two strings are, computed as 1 - distance / length, where distance is the Levenshtein edit distance between the strings and length is the length of the longer one. Both are counted in grapheme clusters. Identical strings, including two empty strings, score 1 and strings with nothing in common score 0. The comparison is case-sensitive. For example: 'hello' and 'hallo' score 0.8.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The first string",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The second string",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *SimilarityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	aClusters, err := graphemeClusters(a)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	bClusters, err := graphemeClusters(b)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	similarity := 1.0
+	if length := max(len(aClusters), len(bClusters)); length > 0 {
+		similarity = 1 - float64(levenshtein(aClusters, bClusters))/float64(length)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, similarity))
+}
+
+// soundexCodes maps consonants to their American Soundex digits; vowels, 'H', 'W' and 'Y' have none
+var soundexCodes = map[rune]byte{
+	'B': '1', 'F': '1', 'P': '1', 'V': '1',
+	'C': '2', 'G': '2', 'J': '2', 'K': '2', 'Q': '2', 'S': '2', 'X': '2', 'Z': '2',
+	'D': '3', 'T': '3',
+	'L': '4',
+	'M': '5', 'N': '5',
+	'R': '6',
+}
+
+// soundex returns the American Soundex code of the ASCII letters in s, or an empty string if it has none
+func soundex(s string) string {
+	var letters []rune
+	for _, r := range strings.ToUpper(s) {
+		if r >= 'A' && r <= 'Z' {
+			letters = append(letters, r)
+		}
+	}
+	if len(letters) == 0 {
+		return ""
+	}
+
+	code := []byte{byte(letters[0])}
+	previous := soundexCodes[letters[0]]
+	for _, r := range letters[1:] {
+		if len(code) == 4 {
+			break
+		}
+		digit, ok := soundexCodes[r]
+		switch {
+		case ok && digit != previous:
+			code = append(code, digit)
+			previous = digit
+		case !ok && r != 'H' && r != 'W':
+			// Vowels separate consonants with the same digit so that both are coded, but 'H' and 'W' do not
+			previous = 0
+		}
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
+
+// SoundexFunction returns the American Soundex code of a name
+var _ function.Function = &SoundexFunction{}
+
+type SoundexFunction struct{}
+
+func NewSoundexFunction() function.Function {
+	return &SoundexFunction{}
+}
+
+func (f *SoundexFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "soundex"
+}
+
+func (f *SoundexFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the Soundex code of a name",
+		Description: "Returns the classic American Soundex code of a name: its first letter followed by three digits for the consonants that follow, so that names that sound alike get the same code. The name is latinized first, so accented letters are coded as their base letters, and characters other than letters are ignored. A name without letters returns an empty string. For example: 'Robert' and 'Rupert' both become 'R163'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The name to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SoundexFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	latinized, err := latinize(input)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, soundex(latinized)))
 }
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,6 +36,36 @@ func TestAsciiFunction(t *testing.T) {
 					resource.TestCheckOutput("test", "Cafe resume"),
 				),
 			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii("Weißbier")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Weibier"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii("Weißbier", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Weissbier"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii("Encyclopædia Þórr Œuvre", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Encyclopaedia THorr OEuvre"),
+				),
+			},
 		},
 	})
 }
@@ -233,6 +265,66 @@ func TestEliteFunction(t *testing.T) {
 					resource.TestCheckOutput("test", "CaFé"),
 				),
 			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("sky")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SKY"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("sky", false)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SKY"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("sky", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SKy"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("señor")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SeÑoR"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("façade")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "FaÇaDe"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::elite("Ørsted")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "øRSTeD"),
+				),
+			},
 		},
 	})
 }
@@ -264,6 +356,36 @@ func TestSpongeFunction(t *testing.T) {
 					resource.TestCheckOutput("test", "cAfÉ-wOrLd"),
 				),
 			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sponge("café")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cAfÉ"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sponge("q\u0301ab")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "q\u0301Ab"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sponge("sp👋onge")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sP👋oNgE"),
+				),
+			},
 		},
 	})
 }
@@ -315,6 +437,8320 @@ func TestLatinizeFunction(t *testing.T) {
 					resource.TestCheckOutput("test", "raksmorgas"),
 				),
 			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::latinize("Crème\n\tbrûlée  à la\r\nmode\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Creme\n\tbrulee  a la\r\nmode\n"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::latinize("é\u00a0è\u2003ê\u3000ë \t ï")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "e\u00a0e\u2003e\u3000e \t i"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::latinize("Tromsø")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Tromso"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::latinize("Łódź, Đakovo, Ħamrun")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Lodz, Dakovo, Hamrun"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::latinize("ılık")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ılık"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("Øresund Łódź")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "oresund_lodz"),
+				),
+			},
+		},
+	})
+}
+
+func TestFoldAsciiFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fold_ascii("Crème Brûlée 世界")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "creme brulee"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fold_ascii("  Hello\t\n  WORLD  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello world"),
+				),
+			},
+		},
+	})
+}
+
+func TestChunkFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::chunk("4111111111111111", 4, " ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4111 1111 1111 1111"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::chunk("ABCDEFGHIJ", 4, "-")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ABCD-EFGH-IJ"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::chunk("åäöåäö", 2, ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "åä.öå.äö"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::chunk("abc", 0, " ")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`size must be greater than zero`),
+			},
+		},
+	})
+}
+
+func TestGroupFromRightFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::group_from_right("1234567", 3, ",")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1,234,567"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::group_from_right("123456", 3, ",")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123,456"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::group_from_right("12", 3, ",")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "12"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::group_from_right("123", -1, ",")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`size must be greater than zero`),
+			},
+		},
+	})
+}
+
+func TestFormatNumberFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_number(1234567.89, ",", ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1,234,567.89"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_number(-1234567, ".", ",")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-1.234.567"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_number(0, ",", ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_number(-0.5, " ", ",")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-0,5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_number(999, ",", ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "999"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandTabsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::expand_tabs("a\tb")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a       b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::expand_tabs("abcdefgh\tx\nabc\ty")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abcdefgh        x\nabc     y"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::expand_tabs("name\tvalue\nid\tx", 4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "name    value\nid  x"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::expand_tabs("\t\tx", 2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "    x"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::expand_tabs("a\tb", 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`tabwidth must be greater than zero`),
+			},
+		},
+	})
+}
+
+func TestAbbreviateNameFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("John Ronald Reuel Tolkien")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "John R. R. Tolkien"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Gabriel José García Márquez")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Gabriel J. G. Márquez"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Иван Сергеевич Тургенев")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Иван С. Тургенев"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Jean-Paul élan Sartre")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Jean-Paul É. Sartre"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("José María Aznar")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "José M. Aznar"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Mary O'Brien Smith")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Mary O. Smith"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Jean-Luc Picard")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Jean-Luc Picard"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Anne Ólafía Þórsdóttir")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Anne Ó. Þórsdóttir"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("Ada Lovelace")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Ada Lovelace"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("  Plato  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Plato"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::abbreviate_name("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestOxfordJoinFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join([])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join(["a"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join(["a", "b"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a and b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join(["a", "b", "c"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a, b, and c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join(["tea", "coffee", "juice"], true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "tea, coffee, or juice"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::oxford_join(["tea", "coffee"], true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "tea or coffee"),
+				),
+			},
+		},
+	})
+}
+
+func TestTitleFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::title("hello world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello World"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::title("hello   WORLD")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello World"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::title("café_au-lait")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Cafe Au Lait"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::title("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestSentenceFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sentence("the QUICK brown Fox")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "The quick brown fox"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sentence("  énorme---SUCCÈS! ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Enorme succes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sentence("!!!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestStripZalgoFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_zalgo("Z\u0335\u0321\u0322\u034e\u0353\u0317a\u0336\u0327\u031b\u0348lgo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Z\u0335\u0321a\u0336\u0327lgo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_zalgo("Tiếng Việt café")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Tiếng Việt café"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_zalgo("Z\u0335\u0321\u0322\u034e\u0353\u0317algo café", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Zalgo cafe"),
+				),
+			},
+		},
+	})
+}
+
+func TestSmartReplaceFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("dog Dog DOG", "dog", "cat")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cat Cat CAT"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("The Master branch; MASTER; master", "master", "main")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "The Main branch; MAIN; main"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("dOg", "dog", "cat")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cat"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("Whitelist", "whitelist", "allow list")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Allow list"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("abc", "", "x")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`old must not be empty`),
+			},
+		},
+	})
+}
+
+func TestDotFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dot("HTTP Requests Total")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "http.requests.total"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dot("...metrics: HTTP 2 requests!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "metrics.http.2.requests"),
+				),
+			},
+		},
+	})
+}
+
+func TestRecaseListFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::recase_list(["Hello World", "foo-bar", "BAZ_QUX", "Crème brûlée", ""], "snake"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"hello_world\",\"foo_bar\",\"baz_qux\",\"creme_brulee\",\"\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::recase_list(["first name", "last-name"], "camel"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"firstName\",\"lastName\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::recase_list([], "kebab"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::recase_list(["a"], "shouty")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown style "shouty"`),
+			},
+		},
+	})
+}
+
+func TestPathFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::path("My Cool Folder")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "my/cool/folder"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::path("/Projects//Café Menu/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "projects/cafe/menu"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::path("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestUrlRemoveParamsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/page?utm_source=news&id=42&fbclid=abc&lang=en#section-2", ["utm_source", "fbclid"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/page?id=42&lang=en#section-2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/?utm_source=x", ["utm_source"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/?b=2&a=1", [])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/?b=2&a=1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/?flag&a=1&b=2", ["a"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/?flag&b=2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/?q=hello%20world&utm%5Fsource=x", ["utm_source"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/?q=hello%20world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("https://example.com/?a=1;b=2&utm_source=x", ["utm_source"])
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com/?a=1;b=2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("http://[::1", ["x"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`missing ']' in host`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("not a url", ["utm_source"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid URL.*scheme and\s+host`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("example.com/?utm_source=x", ["utm_source"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid URL.*scheme and\s+host`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_remove_params("/path?utm_source=x", ["utm_source"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid URL.*scheme and\s+host`),
+			},
+		},
+	})
+}
+
+func TestHttpHeaderFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::http_header("content type")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Content-Type"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::http_header("X_FORWARDED_FOR")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "X-Forwarded-For"),
+				),
+			},
+		},
+	})
+}
+
+func TestCapitalizeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::capitalize("hELLO wORLD")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HELLO wORLD"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::capitalize("123 élan vital")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "123 Élan vital"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::capitalize("iPhone")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IPhone"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::capitalize("42 !?")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "42 !?"),
+				),
+			},
+		},
+	})
+}
+
+func TestHandleFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::handle("Jöhn Doe-Smith!", 30)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "john_doe_smith"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::handle("__Zoë   Saldaña__", 30)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "zoe_saldana"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::handle("Maximilian Alexander Featherstonehaugh", 15)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "maximilian_alex"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::handle("Maximilian Alexander", 11)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "maximilian"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::handle("abc", 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`max_length must be greater than zero`),
+			},
+		},
+	})
+}
+
+func TestStripBidiFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_bidi("access_level = \"user\u202E \u2066// admin\u2069\u2066\"")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "access_level = \"user // admin\""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_bidi("שלום world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "שלום world"),
+				),
+			},
+		},
+	})
+}
+
+func TestHasBidiControlsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::has_bidi_controls("abc\u202Edef")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::has_bidi_controls("שלום world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestTitleAndSlugFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::title_and_slug("Héllo, World!"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "{\"slug\":\"hello-world\",\"title\":\"Hello World\"}"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::title_and_slug("the QUICK brown fox").slug
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "the-quick-brown-fox"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::title_and_slug(""))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "{\"slug\":\"\",\"title\":\"\"}"),
+				),
+			},
+		},
+	})
+}
+
+func TestConvertFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::convert("snake", "Hello World")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello_world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::convert("camel", "Héllo Wörld")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "helloWorld"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::convert("sponge", "Hello World")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hElLo wOrLd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::convert("shouty", "Hello World")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown style "shouty"`),
+			},
+		},
+	})
+}
+
+func TestDetectCaseFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("helloWorld")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "camel"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("hello_world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "snake"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("HELLO-WORLD")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "train"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("HELLO_WORLD")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "upper"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("HelloWorld")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "pascal"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("Hello_World")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ada"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("Hello-World")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "http_header"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("Hello World")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "title"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("Hello world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sentence"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("hello.world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "dot"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("hello/world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "path"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "flat"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("Hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "pascal"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("hello World_foo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "unknown"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::detect_case("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "unknown"),
+				),
+			},
+		},
+	})
+}
+
+func TestStripArticleFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("The Matrix")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Matrix"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("  an   Unexpected Journey ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Unexpected Journey"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("Theory of Everything")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Theory of Everything"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("A")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "A"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("The Matrix", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Matrix, The"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("a Clockwork Orange", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Clockwork Orange, a"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_article("Inception", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Inception"),
+				),
+			},
+		},
+	})
+}
+
+func TestTidyProseFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("  Hello  world ,  this is   messy ;really .  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello world, this is messy; really."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("One  line.\nTwo ,  lines.")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "One line.\nTwo, lines."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose(" a  , b ", { trim = false })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", " a, b "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose(" a  , b ", { collapse_spaces = false, fix_punctuation = false })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a  , b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("1,000 items")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1,000 items"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("costs .5 dollars")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "costs .5 dollars"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("see .gitignore , then stop .")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "see .gitignore, then stop."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("really ?!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "really?!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("a", { trimm = false })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown option "trimm"`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("a", { trim = "no" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`option "trim" must be a bool`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::tidy_prose("a", "trim")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`options must be an object`),
+			},
+		},
+	})
+}
+
+func TestCaseWordBoundaries(t *testing.T) {
+	tests := []struct {
+		function string
+		input    string
+		expected string
+	}{
+		{"snake", "getHTTPResponse", "get_http_response"},
+		{"kebab", "parseURLPath", "parse-url-path"},
+		{"snake", "iOSDevice", "i_os_device"},
+		{"camel", "getHTTPResponse", "getHttpResponse"},
+		{"title", "parseURLPath", "Parse Url Path"},
+		{"upper", "HTTP_STATUS_CODE", "HTTP_STATUS_CODE"},
+		{"snake", "already_snake_case", "already_snake_case"},
+	}
+
+	var steps []resource.TestStep
+	for _, tt := range tests {
+		steps = append(steps, resource.TestStep{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = provider::curious::%s(%q)
+				}
+				`, tt.function, tt.input),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("test", tt.expected),
+			),
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestFitsGraphemeLimitFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("hello", 5)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("hello!", 5)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("hi 👨‍👩‍👧", 4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("🇸🇪🇳🇴", 2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("🇸🇪🇳🇴", 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::fits_grapheme_limit("hello", -1)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`max must not be negative`),
+			},
+		},
+	})
+}
+
+func TestCaseSplitDigitsOption(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("version2Release3")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "version2release3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("version2Release3", { split_digits = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "version_2_release_3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("abc123def", { split_digits = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abc_123_def"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::kebab("v2", { split_digits = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "v-2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("utf8mb4", { split_digits = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "UTF_8_MB_4"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::kebab("file2", { split_digits = false })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "file2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("file2", { split_digit = true })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown option "split_digit"`),
+			},
+		},
+	})
+}
+
+func TestCollapseAllSeparatorsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::collapse_all_separators("foo__bar--baz", "-")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foo-bar-baz"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::collapse_all_separators("My. _Legacy / Name", "_")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "My_Legacy_Name"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::collapse_all_separators("_private--name", ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ".private.name"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::collapse_all_separators("a-b_c", "$1")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a$1b$1c"),
+				),
+			},
+		},
+	})
+}
+
+func TestCaseAcronymsOption(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::camel("parse json api")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "parseJsonApi"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::camel("parse json api", { acronyms = ["JSON", "API"] })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "parseJSONAPI"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::camel("user id json payload", { acronyms = ["ID", "JSON", "URL"] })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "userIDJSONPayload"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("id json payload", { acronyms = ["ID", "JSON", "URL"] })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IDJSONPayload"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("oauth token", { acronyms = ["OAuth"] })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "OAuthToken"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("user id", { acronyms = ["ID"] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown option "acronyms"`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::camel("user id", { acronyms = "ID" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`option "acronyms" must be a list of strings`),
+			},
+		},
+	})
+}
+
+func TestFormatPhoneFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_phone("5551234567", "(###) ###-####")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "(555) 123-4567"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_phone("555.123.4567", "###-###-####")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "555-123-4567"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_phone("46701234567", "+## ##")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "+46 701234567"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::format_phone("55512", "(###) ###-####")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "(555) 12 -    "),
+				),
+			},
+		},
+	})
+}
+
+func TestCaseUnicodeWords(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("Hello World!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HelloWorld"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("ǆemal bijedić")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅemalBijedic"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::camel("novi ǆep")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "noviǅep"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ada("ǄEMAL")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅemal"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("Привет мир")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::kebab("Hello 世界!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnsHostnameFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dns_hostname("Café.Exämple.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cafe.example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dns_hostname("My Web_Server..Example.COM.")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "my-web-server.example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dns_hostname("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dns_hostname("-api-.example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "api.example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::dns_hostname("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb.bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb.bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb.bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb.bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`hostname is 304 characters long, the maximum is 253`),
+			},
+		},
+	})
+}
+
+func TestTitleCaseDigraphs(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ada("ǆ word")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅ_Word"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("ǆungla ǉudi")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅunglaǈudi"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::capitalize("ǆemal")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅemal"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::smart_replace("Foo bar", "foo", "ǆep")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ǅep bar"),
+				),
+			},
+		},
+	})
+}
+
+// TestCaseRoundTrips checks that snake and camel undo each other for identifiers made of
+// lowercase ASCII words of at least two letters, without digits, as documented in the README.
+func TestCaseRoundTrips(t *testing.T) {
+	snakeIdentifiers := []string{"get_http_response", "user_id", "parse_url_path", "is_ok", "max_retry_count"}
+	camelIdentifiers := []string{"getHttpResponse", "userId", "parseUrlPath", "isOk", "maxRetryCount"}
+
+	var steps []resource.TestStep
+	for _, identifier := range snakeIdentifiers {
+		steps = append(steps, resource.TestStep{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = provider::curious::snake(provider::curious::camel(%q))
+				}
+				`, identifier),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("test", identifier),
+			),
+		})
+	}
+	for _, identifier := range camelIdentifiers {
+		steps = append(steps, resource.TestStep{
+			Config: fmt.Sprintf(`
+				output "test" {
+					value = provider::curious::camel(provider::curious::snake(%q))
+				}
+				`, identifier),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("test", identifier),
+			),
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestCaseLocaleOption(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("istanbul")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ISTANBUL"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("istanbul", { locale = "tr" })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "İSTANBUL"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("ılık", { locale = "tr" })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ILIK"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("ILIK İZMİR", { locale = "tr" })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ılık_izmir"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("ILIK İZMİR")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ilik_izmir"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pascal("istanbul ılık", { locale = "tr" })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "İstanbulIlık"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("a", { locale = "not a locale!" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`invalid locale "not a locale!"`),
+			},
+		},
+	})
+}
+
+func TestCaseSharpS(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("straße")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "STRASSE"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("fußball")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "FUSSBALL"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::train("fußball verein")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "FUSSBALL-VEREIN"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::upper("straße", { capital_sharp_s = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "STRAẞE"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::train("fußball verein", { capital_sharp_s = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "FUẞBALL-VEREIN"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::snake("Fußball", { capital_sharp_s = true })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown option "capital_sharp_s"`),
+			},
+		},
+	})
+}
+
+func TestNfkcFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::nfkc("ﬁle")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "file"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::nfkc("２０２４")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::nfkc("№ 5 ﬂoor")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "No 5 floor"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::flat(provider::curious::nfkc("Ｍｙ ﬁle"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "myfile"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::normalize("NFC", "e\u0301") == "\u00e9"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::normalize("NFD", "\u00e9") == provider::curious::normalize("NFC", "e\u0301")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::normalize("nfkc", "ﬁle №1")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "file No1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::normalize("NFKD", "２０２４")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::normalize("NFX", "abc")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown normalization form "NFX"`),
+			},
+		},
+	})
+}
+
+func TestCyrillicToLatinFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Привет")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Privet"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Щука, ЖУК и Хрущёв")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Shchuka, ZHUK i Khrushchev"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Hello, мир!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello, mir!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Київ", "uk")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Kyiv"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Григорій Юлія", "uk")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hryhorii Yuliia"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("it's Київ", "uk")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "it's Kyiv"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Знам’янка, м'ята", "uk")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Znamianka, miata"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("'Київ' і ‘Львів’", "uk")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "'Kyiv' i ‘Lviv’"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Григорий", "ru")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Grigoriy"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::cyrillic_to_latin("Привет", "be")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown variant "be"`),
+			},
+		},
+	})
+}
+
+func TestGreekToLatinFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("Αθήνα")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Athina"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("Ψυχή και Χάος")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Psychi kai Chaos"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("Θεσσαλονίκη ΟΔΟΣ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Thessaloniki ODOS"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("ουρανός, αύριο, Ευρώπη, άγγελος")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ouranos, avrio, Evropi, angelos"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("Ταΰγετος")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Taygetos"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("Ευτυχία, αυτός, Ευάγγελος")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Eftychia, aftos, Evangelos"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("ευ ηύρα")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ef ivra"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::greek_to_latin("café Γεια")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "café Geia"),
+				),
+			},
+		},
+	})
+}
+
+func TestStripEmojiFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("hello 👋🏽 world 🎉")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello  world "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("Go 🇸🇪🇳🇴!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Go !"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("we are 👨‍👩‍👧 family")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "we are  family"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("press 1️⃣ or 2")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "press  or 2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("© 2024 Curious™ ❤️")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "© 2024 Curious™ "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("rate ★★★☆☆ ⭐")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "rate ★★★☆☆ "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("A ↔ B, A ↔\uFE0F B")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "A ↔ B, A  B"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_emoji("Ünïcödé ✓")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Ünïcödé ✓"),
+				),
+			},
+		},
+	})
+}
+
+func TestLengthFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length("q\u0301\u0323")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length("👨‍👩‍👧")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length("a\r\nb")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestReverseFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::reverse("café")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "éfac"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::reverse("xq\u0301z")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "zq\u0301x"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::reverse("a👨‍👩‍👧b")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "b👨\u200d👩\u200d👧a"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::reverse("🇸🇪🇳🇴")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "🇳🇴🇸🇪"),
+				),
+			},
+		},
+	})
+}
+
+func TestRandomCaseFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("hello world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "heLLo WorLD"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("hello world") == provider::curious::random_case("hello world")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("hello world", "abc")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HeLLo WORLd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("Terraform is fun!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "TErraFOrM IS FUn!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = lower(provider::curious::random_case("Terraform is fun!"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "terraform is fun!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::random_case("hello", "a", "b")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestSlugifyFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Héllo, World! 123")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello-world-123"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Straße im  Großen Garten")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "strasse-im-grossen-garten"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("getHTTPResponse")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "get-http-response"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("--- !!! ---")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 12)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 15)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello-wonderful"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 16)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello-wonderful"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 100)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello-wonderful-world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Supercalifragilistic", 5)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "super"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 11, "_")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", 16, "_")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello_wonderful"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello Wonderful World", null, ".")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello.wonderful.world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello World", 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)greater.*than.*zero`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello World", 2.5)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)whole.*number`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello World", "ten")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)must.*be.*a.*number`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::slugify("Hello World", 10, "-", "x")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too.*many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestTruncateFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 8)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 11)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello World"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 20)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello World"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 10)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 7)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 6)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hel…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 9, "...")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello..."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 5, "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "👨\u200d👩\u200d👧👨\u200d👩\u200d👧👨\u200d👩\u200d👧"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("👨‍👩‍👧👨‍👩‍👧👨‍👩‍👧", 2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "👨\u200d👩\u200d👧…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("q\u0301q\u0301q\u0301q\u0301", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "q\u0301q\u0301…"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::truncate("Hello World", 2, "...")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)at.*least.*the.*length.*of.*the.*ellipsis`),
+			},
+		},
+	})
+}
+
+func TestCenterFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hi", 6, "*")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "**hi**"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hi", 7, "*")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "**hi***"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hi", 6)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  hi  "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hello", 3, "*")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hi", 9, "-=")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-=-hi-=-="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("q\u0301", 3, "🇸🇪")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "🇸🇪q\u0301🇸🇪"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::center("hi", 6, "")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)pad.*must.*not.*be.*empty`),
+			},
+		},
+	})
+}
+
+func TestRepeatFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("ab", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ababab"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("ab", 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ab"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("ab", 0)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("", 1000000000)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::length(provider::curious::repeat("a", 1048576))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1048576"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("ab", 524289)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)more.*than.*the.*limit.*of.*1048576.*bytes`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::repeat("ab", -1)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)count.*must.*not.*be.*negative`),
+			},
+		},
+	})
+}
+
+func TestWordCountFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count("Hello, world! Foo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count("one--two__three...four  five")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count("...Hello world!!!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count("Café au lait, 2 Привет")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_count(" ?! -- ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCharCountFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("héllo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("he\u0301llo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("q\u0301q\u0301")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("hi 👋🏽")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("👨‍👩‍👧🇸🇪")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::char_count("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestLineCountFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\nb\nc")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\r\nb\r\nc")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\nb\nc\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\r\nb\r\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\nb\nc\n", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("a\n\nb")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("single line")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::line_count("", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestToCharsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars("café"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"c\",\"a\",\"f\",\"é\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars("q\u0301a"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"q\u0301\",\"a\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars("a👨‍👩‍👧b"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"a\",\"👨\u200d👩\u200d👧\",\"b\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars("👋🏽🇸🇪"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"👋🏽\",\"🇸🇪\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars("a\r\nb"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"a\",\"\\r\\n\",\"b\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::to_chars(""))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[]"),
+				),
+			},
+		},
+	})
+}
+
+func TestWordsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::words("getHTTPResponse_v2"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"get\",\"HTTP\",\"Response\",\"v2\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::words("version2Release3", { split_digits = true }))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"version\",\"2\",\"Release\",\"3\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::words("Crème brûlée--for TWO"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"Crème\",\"brûlée\",\"for\",\"TWO\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::words("XMLHttpRequest"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[\"XML\",\"Http\",\"Request\"]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::words(" -- "))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "[]"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::words("a", { locale = "tr" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*option.*"locale"`),
+			},
+		},
+	})
+}
+
+func TestAllCasesFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::all_cases("Hello World").snake
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello_world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::all_cases("Hello World").camel
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "helloWorld"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::all_cases("Hello World").train
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HELLO-WORLD"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsonencode(provider::curious::all_cases("Crème brûlée"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "{\"ada\":\"Creme_Brulee\",\"camel\":\"cremeBrulee\",\"flat\":\"cremebrulee\",\"kebab\":\"creme-brulee\",\"pascal\":\"CremeBrulee\",\"snake\":\"creme_brulee\",\"train\":\"CREME-BRULEE\",\"upper\":\"CREME_BRULEE\"}"),
+				),
+			},
+		},
+	})
+}
+
+func TestIsBlankFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("   \t\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("x")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("  x  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("\u00a0\u2003\u3000")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("\u200b")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank(" \u200b\ufeff ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::is_blank("\u200bx")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestCoalesceNonblankFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::coalesce_nonblank("", "  ", "real", "fallback")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "real"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::coalesce_nonblank("first", "second")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "first"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::coalesce_nonblank("\t\n", "\u00a0", " padded ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", " padded "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::coalesce_nonblank("", " ", "\u200b")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)no.*non-blank.*argument`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::coalesce_nonblank()
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)no.*non-blank.*argument`),
+			},
+		},
+	})
+}
+
+func TestBase64Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("Hello?>")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SGVsbG8/Pg=="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("Hello?>", "std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SGVsbG8/Pg=="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("Hello?>", "urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SGVsbG8_Pg=="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("Hello?>", "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SGVsbG8/Pg"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("Hello?>", "raw_urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SGVsbG8_Pg"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8/Pg==")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello?>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8_Pg==", "urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello?>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8/Pg", "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello?>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8_Pg", "raw_urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello?>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode(provider::curious::base64encode("Crème brûlée 👋", "std"), "std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode(provider::curious::base64encode("Crème brûlée 👋", "urlsafe"), "urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode(provider::curious::base64encode("Crème brûlée 👋", "raw_std"), "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode(provider::curious::base64encode("Crème brûlée 👋", "raw_urlsafe"), "raw_urlsafe")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8_Pg==")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*base64`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("SGVsbG8/Pg", "std")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*base64`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64decode("/w==")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)not.*valid.*UTF-8`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base64encode("x", "url")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*variant.*"url"`),
+			},
+		},
+	})
+}
+
+func TestBase32Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32encode("foobar")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "MZXW6YTBOI======"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32encode("foobar", "hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "CPNMUOJ1E8======"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32encode("foobar", "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "MZXW6YTBOI"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32encode("foobar", "raw_hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "CPNMUOJ1E8"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("MZXW6YTBOI======")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foobar"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("CPNMUOJ1E8======", "hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foobar"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("MZXW6YTBOI", "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foobar"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("CPNMUOJ1E8", "raw_hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foobar"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode(provider::curious::base32encode("Crème brûlée 👋", "std"), "std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode(provider::curious::base32encode("Crème brûlée 👋", "hex"), "hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode(provider::curious::base32encode("Crème brûlée 👋", "raw_std"), "raw_std")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode(provider::curious::base32encode("Crème brûlée 👋", "raw_hex"), "raw_hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("MZXW6YTB0I======")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*base32`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32decode("MZXW6YTBOI")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*base32`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::base32encode("x", "base32hex")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*variant.*"base32hex"`),
+			},
+		},
+	})
+}
+
+func TestHexFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_encode("Hi")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4869"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_encode("Hi?")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "48693f"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_encode("Hi?", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "48693F"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_encode("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode("4869")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hi"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode("48693F")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hi?"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode(provider::curious::hex_encode("Crème brûlée 👋"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode(provider::curious::hex_encode("Crème brûlée 👋", true))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode("486")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*hex.*odd.*length`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode("48zz")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*hex.*invalid.*byte`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::hex_decode("ff")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)not.*valid.*UTF-8`),
+			},
+		},
+	})
+}
+
+func TestUrlFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_encode("a b&c")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a%20b%26c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_encode("a b&c", "query")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a+b%26c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_encode("a b&c/d", "path")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a%20b&c%2Fd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_encode("A-z_0.9~ é/?+")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "A-z_0.9~%20%C3%A9%2F%3F%2B"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("a%20b%26c")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a b&c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("a+b%26c")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a+b&c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("a+b%26c", "query")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a b&c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("%c3%a9")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "é"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode(provider::curious::url_encode("Crème brûlée & 1+1=2?"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée & 1+1=2?"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode(provider::curious::url_encode("Crème brûlée & 1+1=2?", "query"), "query")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée & 1+1=2?"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode(provider::curious::url_encode("Crème brûlée & 1+1=2?", "path"), "path")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée & 1+1=2?"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("100%")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*percent-encoding`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("%zz")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*percent-encoding`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_decode("%ff")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)not.*valid.*UTF-8`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::url_encode("x", "form")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*style.*"form"`),
+			},
+		},
+	})
+}
+
+func TestHtmlFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_escape("<a href=\"x\">")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "&lt;a href=&#34;x&#34;&gt;"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_escape("Tom & Jerry's")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Tom &amp; Jerry&#39;s"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_escape("Crème brûlée")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_unescape("&lt;a href=&#34;x&#34;&gt;")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "<a href=\"x\">"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_unescape("&amp;&apos;&quot;&#39;&#x27;&eacute;")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "&'\"''é"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_unescape("AT&T &bogus;")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "AT&T &bogus;"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::html_unescape(provider::curious::html_escape("<p class='a'>\"x\" & y</p>"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "<p class='a'>\"x\" & y</p>"),
+				),
+			},
+		},
+	})
+}
+
+func TestXmlEscapeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("a < b & c > d")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a &lt; b &amp; c &gt; d"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("it's \"quoted\"")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "it&#39;s &#34;quoted&#34;"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("<>&'\"")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "&lt;&gt;&amp;&#39;&#34;"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("a\tb\nc\rd")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a&#x9;b&#xA;c&#xD;d"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("bell\u0007 é")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bell� é"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("bell\u0007 é", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bell é"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::xml_escape("a\tb\u0000c", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a&#x9;bc"),
+				),
+			},
+		},
+	})
+}
+
+func TestJsonEscapeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("line1\ntab\there \"quote\"")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "line1\\ntab\\there \\\"quote\\\""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("back\\slash / slash")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "back\\\\slash / slash"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("bell\u0007\r\u001f")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bell\\u0007\\r\\u001f"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("say \"hi\"", { quote = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "\"say \\\"hi\\\"\""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("café 👋")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "café 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("café 👋", { ascii = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "caf\\u00e9 \\ud83d\\udc4b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("é\n", { quote = true, ascii = true })
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "\"\\u00e9\\n\""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = jsondecode(provider::curious::json_escape("a\"b\\c\nd é", { quote = true, ascii = true }))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a\"b\\c\nd é"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::json_escape("x", { wrap = true })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*option.*"wrap"`),
+			},
+		},
+	})
+}
+
+func TestRotNFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("abc", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "def"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("Hello, World!", 13)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Uryyb, Jbeyq!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n(provider::curious::rot_n("Hello, World!", 13), 13)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello, World!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("xyz XYZ", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abc ABC"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("def", -3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abc"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("abc", -29)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "xyz"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("abc", 29)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "def"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("abc", 52)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abc"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::rot_n("café 123", 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "dbgé 123"),
+				),
+			},
+		},
+	})
+}
+
+func TestCaesarFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("abc123", 1, true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bcd234"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("abc123", 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bcd123"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("abc123", 1, false)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bcd123"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("Zz 9", 1, true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Aa 0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("bcd234", -1, true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abc123"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::caesar("a0", 27, true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "b7"),
+				),
+			},
+		},
+	})
+}
+
+func TestMd5Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::md5("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "5d41402abc4b2a76b9719d911017c592"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::md5("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "d41d8cd98f00b204e9800998ecf8427e"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::md5("é")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "66ddcd97cfdeabb2f6fb8a999b4bc76f"),
+				),
+			},
+		},
+	})
+}
+
+func TestSha1Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha1("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha1("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "da39a3ee5e6b4b0d3255bfef95601890afd80709"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha1("é")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bf15be717ac1b080b4f1c456692825891ff5073d"),
+				),
+			},
+		},
+	})
+}
+
+func TestSha256Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("hello", "hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("hello", "base64")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("hello", "base64url")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "LPJNul-wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha256("hello", "HEX")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*encoding.*"HEX"`),
+			},
+		},
+	})
+}
+
+func TestSha512Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha512("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha512("hello", "base64")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "m3HSJL1i83hdltRq0+o9czGb+8KJDKra4t/3JRlnPKcjI8PZm6XBHXx6zG4UuMXaDEZjR1wuXDre9G9zvN7AQw=="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha512("hello", "base64url")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "m3HSJL1i83hdltRq0-o9czGb-8KJDKra4t_3JRlnPKcjI8PZm6XBHXx6zG4UuMXaDEZjR1wuXDre9G9zvN7AQw=="),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::sha512("hello", "base32")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*encoding.*"base32"`),
+			},
+		},
+	})
+}
+
+func TestCrc32Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3610a686"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("hello", "hex")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3610a686"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("hello", "decimal")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "907060870"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "00000000"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("a")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "e8b7be43"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::crc32("hello", "number")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*format.*"number"`),
+			},
+		},
+	})
+}
+
+func TestPunycodeEncodeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("münchen")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "mnchen-3ya"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("münchen", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "xn--mnchen-3ya"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("☃")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "n3h"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("example")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example-"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("example", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_encode("bücher.de")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)single.*domain.*label`),
+			},
+		},
+	})
+}
+
+func TestPunycodeDecodeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("mnchen-3ya")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "münchen"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("n3h")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "☃"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("xn--mnchen-3ya", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "münchen"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("XN--mnchen-3ya", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "münchen"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("example", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode(provider::curious::punycode_encode("Ταΰγετος"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Ταΰγετος"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode(provider::curious::punycode_encode("bücher", true), true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bücher"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode(provider::curious::punycode_encode("plain", true), true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "plain"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode(provider::curious::punycode_encode("example"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("abc")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)control.*characters`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("abc-!!")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*Punycode`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::punycode_decode("xn--99999999999", true)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*Punycode`),
+			},
+		},
+	})
+}
+
+func TestAscii85Function(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_encode("hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "BOu!rDZ"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_encode("hello", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "<~BOu!rDZ~>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_encode("Man is")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "9jqo^Bla"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode("BOu!rDZ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode("<~BOu!rDZ~>")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode("  <~BOu!r\n  DZ~>\n")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode(provider::curious::ascii85_encode("Crème brûlée 👋"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode(provider::curious::ascii85_encode("Crème brûlée 👋", true))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode("abc{")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*Ascii85`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ascii85_decode("s8W-!")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)not.*valid.*UTF-8`),
+			},
+		},
+	})
+}
+
+func TestQuotedPrintableFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable("héllo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "h=C3=A9llo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable("a=b")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a=3Db"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable("abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcde=\r\nfghijabcdefghij"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable("line one\nline two")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "line one\r\nline two"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode("h=C3=A9llo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "héllo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode("h=c3=a9llo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "héllo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode("soft=\r\nbreak")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "softbreak"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode(provider::curious::quoted_printable("Crème brûlée 👋 = dessert"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Crème brûlée 👋 = dessert"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode(provider::curious::quoted_printable("abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij é abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij é abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghijabcdefghij"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::quoted_printable_decode("=FF")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)not.*valid.*UTF-8`),
+			},
+		},
+	})
+}
+
+func TestMorseEncodeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("SOS")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "... --- ..."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("sos")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "... --- ..."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("Agent 007")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ".- --. . -. - / ----- ----- --..."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("  Hi,   there!  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ".... .. --..-- / - .... . .-. . -.-.--"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("café")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-.-. .- ..-. ."),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_encode("50%")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)character.*'%'.*has.*no.*Morse.*code`),
+			},
+		},
+	})
+}
+
+func TestMorseDecodeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode("... --- ...")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "SOS"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode(".- --. . -. - / ----- ----- --...")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "AGENT 007"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode("  ....  ..  /  - .... . .-. .  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HI THERE"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode(".... .. // - .... . .-. .")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HI THERE"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode(provider::curious::morse_encode("Hello, World! 1+1=2?"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "HELLO, WORLD! 1+1=2?"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode(provider::curious::morse_encode("user@example.com (test)"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "USER@EXAMPLE.COM (TEST)"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode("")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode("... ------- ...")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*Morse.*code.*"-------"`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::morse_decode("... abc")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid.*Morse.*code.*"abc"`),
+			},
+		},
+	})
+}
+
+func TestLeetspeakFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("elite")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3l173"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("elite", "basic")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3l173"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("LEET Hacker!")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "L337 H4ck3r!"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("elite", "aggressive")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "31173"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("Hack the planet", "aggressive")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "#4(|< 7#3 |>14|\\|37"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("café 42", "aggressive")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "(4|=é 42"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::leetspeak("elite", "max")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)unknown.*level.*"max"`),
+			},
+		},
+	})
+}
+
+func TestInitialsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("John Ronald Reuel Tolkien")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "JRRT"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("John Ronald Reuel Tolkien", 2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "JR"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("John Ronald Reuel Tolkien", 10)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "JRRT"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("émile zola")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "EZ"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("Jean-Luc Picard")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "JLP"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::initials("John Doe", 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)max_count.*must.*be.*greater.*than.*zero`),
+			},
+		},
+	})
+}
+
+func TestOrdinalFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1st"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2nd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3rd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "4th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(11)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "11th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(12)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "12th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(13)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "13th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(21)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "21st"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(22)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "22nd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(111)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "111th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(1002)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1002nd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(0)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(-3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-3rd"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(-112)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "-112th"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ordinal(2.5)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)integer`),
+			},
+		},
+	})
+}
+
+func TestPluralizeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("item")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "items"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("key")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "keys"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("city")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cities"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("bus")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "buses"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("box")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "boxes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("church")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "churches"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("dish")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "dishes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("quiz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "quizzes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("Quiz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Quizzes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("waltz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "waltzes"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("quiz"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "quiz"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("person")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "people"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("Child")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Children"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("sheep")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sheep"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("CITY")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "CITIES"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("security group")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "security groups"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("item", 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "item"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("item", 0)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "items"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::pluralize("city", 3)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cities"),
+				),
+			},
+		},
+	})
+}
+
+func TestSingularizeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("items")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "item"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("cities")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "city"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("buses")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bus"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("houses")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "house"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("Boxes")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Box"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("CITIES")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "CITY"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("people")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "person"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("Children")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Child"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("sheep")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sheep"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("class")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "class"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("status")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "status"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("security groups")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "security group"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("aliases")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "alias"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("gases")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "gas"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("canvases")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "canvas"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("movies")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "movie"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("cookies")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cookie"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("databases")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "database"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("Cases")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Case"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize("purposes")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "purpose"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("alias"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "alias"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("gas"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "gas"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("canvas"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "canvas"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("iris"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "iris"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("movie"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "movie"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("database"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "database"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("release"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "release"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("item"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "item"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("key"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "key"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("city"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "city"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("bus"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "bus"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("box"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "box"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("church"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "church"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("dish"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "dish"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("class"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "class"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("waltz"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "waltz"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("person"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "person"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("child"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "child"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::singularize(provider::curious::pluralize("status"))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestRomanFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(2024)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "MMXXIV"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "I"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "IV"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(1994)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "MCMXCIV"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(3999)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "MMMCMXCIX"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)out of\s+range`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman(4000)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)out of\s+range`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("MMXXIV")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("mcmxciv")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1994"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("IIII")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid\s+Roman\s+numeral`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("IC")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid\s+Roman\s+numeral`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("MMXA")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid\s+Roman\s+numeral`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int("")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid\s+Roman\s+numeral`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(1))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(9))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "9"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(14))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "14"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(40))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "40"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(444))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "444"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(1666))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1666"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(2024))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::roman_to_int(provider::curious::roman(3999))
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "3999"),
+				),
+			},
+		},
+	})
+}
+
+func TestStripTagsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("<b>Hello</b> <i>world</i>")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Hello world"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("<div><p>Nested <span>tags <em>here</em></span></p></div>")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Nested tags here"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("Line<br/>break<img src=\"x.png\" />")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Linebreak"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("Fish &amp; chips &lt;3 &eacute;&#233;")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Fish & chips <3 éé"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("<p>Unclosed <b>bold")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Unclosed bold"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("a <b")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("1 < 2")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1 < 2"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("<!-- note -->Text<script>alert(1)</script><style>p {}</style>")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Text"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::strip_tags("plain text")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "plain text"),
+				),
+			},
+		},
+	})
+}
+
+func TestSqueezeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("a   b\t\tc")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a b c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("  lots \n\n of \r\n  space  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "lots of space"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("no\u00a0\u00a0break\u2003em")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "no break em"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("  keep ends  ", false)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", " keep ends "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("\t\tleft only", false)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", " left only"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("   ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("   ", false)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", " "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("tidy")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "tidy"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::squeeze("a", true, false)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestTrimFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("xxhelloxx", "x")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("-_=hello=_-", "=_-")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("[[a-b]]", "[]")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a-b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("«élan»", "»«")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "élan"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim(" \t hello \n", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("hello", "xyz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim("xxxx", "x")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_left("xyxhelloxyx", "yx")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "helloxyx"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_left("  hello  ", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello  "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_right("xyxhelloxyx", "yx")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "xyxhello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_right("  hello  ", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  hello"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_right("path///", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestTrimPrefixFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_prefix("https://example.com", "https://", "http://")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_prefix("http://example.com", "https://", "http://")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_prefix("ftp://example.com", "https://", "http://")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ftp://example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_prefix("aaab", "a", "aa")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "aab"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_prefix("unchanged")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "unchanged"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_suffix("archive.tar.gz", ".zip", ".tar.gz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "archive"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_suffix("archive.zip", ".zip", ".tar.gz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "archive"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_suffix("photo.png", ".zip", ".tar.gz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "photo.png"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::trim_suffix("file.txt.txt", ".txt", ".txt.txt")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "file.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnsurePrefixFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("example.com", "https://")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("https://example.com", "https://")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("foo", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/foo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("/foo", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/foo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix(provider::curious::ensure_prefix("foo", "/"), "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/foo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_prefix("foo", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "foo"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_suffix("/foo", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/foo/"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_suffix("/foo/", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/foo/"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_suffix("report", ".csv")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "report.csv"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_suffix("report.csv", ".csv")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "report.csv"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::ensure_suffix("", "/")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "/"),
+				),
+			},
+		},
+	})
+}
+
+func TestMaskFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("4111111111111111", 0, 4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "************1111"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("secretvalue", 3, 0)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sec********"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("secretvalue", 2, 2)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "se*******ue"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("secretvalue", 0, 0)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "***********"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("4111111111111111", 0, 4, "#")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "############1111"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("4111111111111111", 0, 4, "•")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "••••••••••••1111"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("q\u0301ue\u0301bec", 1, 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "q\u0301****c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("👍🏽👍🏽👍🏽", 0, 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "**👍🏽"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("1234", 0, 4)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "****"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("abc", 2, 5)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "***"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("", 1, 1)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("abc", -1, 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)keep_first must not be\s+negative`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("abc", 0, -1)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)keep_last must not be\s+negative`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("abc", 0, 1, "")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)mask_char must not be\s+empty`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask("abc", 0, 1, "*", "#")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestMaskEmailFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("john.doe@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "j******e@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("abc@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a*c@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("ab@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a*@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("a@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "*@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("\"odd@local\"@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "\"*********\"@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("josé@example.com")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "j**é@example.com"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("john.doe")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid email\s+address`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("@example.com")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid email\s+address`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::mask_email("john@")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid email\s+address`),
+			},
+		},
+	})
+}
+
+func TestRedactFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("token=ghp_abc123 user=bob", "ghp_[A-Za-z0-9]+")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "token=[REDACTED] user=bob"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("a=sk-1 b=sk-22 c=none", "sk-[0-9]+")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a=[REDACTED] b=[REDACTED] c=none"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("password: hunter2", "(?i)(password: )\\S+", "***")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "***"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("cost $5", "[0-9]+", "$1")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "cost $$1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("nothing secret here", "ghp_[A-Za-z0-9]+")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "nothing secret here"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("abc", "(")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)invalid\s+pattern`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::redact("abc", "b", "x", "y")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestWordWrapFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("the quick brown fox jumped", 10)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "the quick\nbrown fox\njumped"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("the quick brown fox jumped", 9)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "the quick\nbrown fox\njumped"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("the quick brown fox jumped", 100)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "the quick brown fox jumped"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("a supercalifragilistic word", 10)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "a\nsupercalifragilistic\nword"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("supercalifragilistic", 5)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "supercalifragilistic"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("first line\n\nsecond   paragraph here", 12)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "first line\n\nsecond\nparagraph\nhere"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("q\u0301q\u0301q\u0301 été", 7)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "q\u0301q\u0301q\u0301 été"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("", 10)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::word_wrap("abc", 0)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)width must be greater\s+than\s+zero`),
+			},
+		},
+	})
+}
+
+func TestIndentFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\nb\nc", "  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  a\n  b\n  c"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\nb\n", "  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  a\n  b\n"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\nb\n", "  ", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  a\n  b\n"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\n\nb", "> ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "> a\n\n> b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\n  \nb", "> ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "> a\n  \n> b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a\n\nb", "> ", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "> a\n> \n> b"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("single", "\t")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "\tsingle"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("", "  ")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("", "  ", true)
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "  "),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::indent("a", "  ", true, false)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)too many.*arguments`),
+			},
+		},
+	})
+}
+
+func TestSimilarityFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("hello", "hallo")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0.8"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("hello", "hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "1"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("abc", "xyz")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("abc", "")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("kitten", "sitting")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0.5714285714285714"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("flaw", "lawn")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0.5"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("Hello", "hello")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0.8"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::similarity("q\u0301rs", "qrs")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "0.6666666666666667"),
+				),
+			},
+		},
+	})
+}
+
+func TestSoundexFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Robert")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "R163"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Rupert")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "R163"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Rubin")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "R150"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Ashcraft")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "A261"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Ashcroft")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "A261"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Tymczak")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "T522"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Pfister")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "P236"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Honeyman")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "H555"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Lee")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "L000"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("robert")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "R163"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Müller")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "M460"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Mueller")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "M460"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("Émile")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "E540"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("O'Brien")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "O165"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::curious::soundex("123")
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
 		},
 	})
 }
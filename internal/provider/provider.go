@@ -71,7 +71,106 @@ func (p *curiousProvider) Functions(_ context.Context) []func() function.Functio
 		NewUpperFunction,
 		NewTrainFunction,
 		NewAdaFunction,
+		NewTitleFunction,
+		NewSentenceFunction,
+		NewDotFunction,
+		NewPathFunction,
+		NewHttpHeaderFunction,
 		NewEliteFunction,
 		NewSpongeFunction,
+		NewRandomCaseFunction,
+		NewFoldAsciiFunction,
+		NewNfkcFunction,
+		NewNormalizeFunction,
+		NewChunkFunction,
+		NewGroupFromRightFunction,
+		NewFormatNumberFunction,
+		NewExpandTabsFunction,
+		NewAbbreviateNameFunction,
+		NewOxfordJoinFunction,
+		NewStripZalgoFunction,
+		NewSmartReplaceFunction,
+		NewRecaseListFunction,
+		NewConvertFunction,
+		NewDetectCaseFunction,
+		NewUrlRemoveParamsFunction,
+		NewCapitalizeFunction,
+		NewHandleFunction,
+		NewStripBidiFunction,
+		NewHasBidiControlsFunction,
+		NewTitleAndSlugFunction,
+		NewStripArticleFunction,
+		NewTidyProseFunction,
+		NewFitsGraphemeLimitFunction,
+		NewCollapseAllSeparatorsFunction,
+		NewFormatPhoneFunction,
+		NewDnsHostnameFunction,
+		NewCyrillicToLatinFunction,
+		NewGreekToLatinFunction,
+		NewStripEmojiFunction,
+		NewLengthFunction,
+		NewReverseFunction,
+		NewSlugifyFunction,
+		NewTruncateFunction,
+		NewCenterFunction,
+		NewRepeatFunction,
+		NewWordCountFunction,
+		NewCharCountFunction,
+		NewLineCountFunction,
+		NewToCharsFunction,
+		NewWordsFunction,
+		NewAllCasesFunction,
+		NewIsBlankFunction,
+		NewCoalesceNonblankFunction,
+		NewBase64EncodeFunction,
+		NewBase64DecodeFunction,
+		NewBase32EncodeFunction,
+		NewBase32DecodeFunction,
+		NewHexEncodeFunction,
+		NewHexDecodeFunction,
+		NewUrlEncodeFunction,
+		NewUrlDecodeFunction,
+		NewHtmlEscapeFunction,
+		NewHtmlUnescapeFunction,
+		NewXmlEscapeFunction,
+		NewJsonEscapeFunction,
+		NewRotNFunction,
+		NewCaesarFunction,
+		NewMd5Function,
+		NewSha1Function,
+		NewSha256Function,
+		NewSha512Function,
+		NewCrc32Function,
+		NewPunycodeEncodeFunction,
+		NewPunycodeDecodeFunction,
+		NewAscii85EncodeFunction,
+		NewAscii85DecodeFunction,
+		NewQuotedPrintableFunction,
+		NewQuotedPrintableDecodeFunction,
+		NewMorseEncodeFunction,
+		NewMorseDecodeFunction,
+		NewLeetspeakFunction,
+		NewInitialsFunction,
+		NewOrdinalFunction,
+		NewPluralizeFunction,
+		NewSingularizeFunction,
+		NewRomanFunction,
+		NewRomanToIntFunction,
+		NewStripTagsFunction,
+		NewSqueezeFunction,
+		NewTrimFunction,
+		NewTrimLeftFunction,
+		NewTrimRightFunction,
+		NewTrimPrefixFunction,
+		NewTrimSuffixFunction,
+		NewEnsurePrefixFunction,
+		NewEnsureSuffixFunction,
+		NewMaskFunction,
+		NewMaskEmailFunction,
+		NewRedactFunction,
+		NewWordWrapFunction,
+		NewIndentFunction,
+		NewSimilarityFunction,
+		NewSoundexFunction,
 	}
 }